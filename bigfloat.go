@@ -97,6 +97,9 @@ type DivOption func(*divOptionsType)
 type divOptionsType struct {
 	decimalPlaces    int
 	maxDecimalPlaces int
+	roundingMode     RoundingMode
+	infOnZero        bool
+	conditions       *Condition
 }
 
 /*
@@ -120,6 +123,15 @@ func WithDivMaxDecimalPlaces(maxDecimalPlaces int) DivOption {
 	}
 }
 
+/*
+Function defines if division by zero returns infinity (or NaN for 0 / 0) instead of error
+*/
+func WithDivInfOnZero(infOnZero bool) DivOption {
+	return func(ro *divOptionsType) {
+		ro.infOnZero = infOnZero
+	}
+}
+
 /*
 Function type for rounding option.
 */
@@ -127,6 +139,8 @@ type RoundOption func(*roundOptionsType)
 
 type roundOptionsType struct {
 	decimalPlaces int
+	roundingMode  RoundingMode
+	conditions    *Condition
 }
 
 /*
@@ -342,11 +356,38 @@ func align(args ...*BigFloat) alignment {
 }
 
 /*
-Parse string into BigFloat number
+Function type for parsing option
+
+See: SetString
+*/
+type ParseOption func(*parseOptionsType)
+
+type parseOptionsType struct {
+	negZero bool
+}
+
+/*
+Function defines if zero with '-' sign (e.g. "-0.00") is parsed as negative zero (default is zero without sign)
+
+See: NegZero
+*/
+func WithParseNegZero(negZero bool) ParseOption {
+	return func(po *parseOptionsType) {
+		po.negZero = negZero
+	}
+}
+
+/*
+Parse string into BigFloat number with ParseOption
 If parsing failed returns error
 */
-func (f *BigFloat) SetString(s string) error {
-	analysis, error := stranalyzer.Analyze(s)
+func (f *BigFloat) SetString(s string, options ...ParseOption) error {
+	po := parseOptionsType{}
+	for _, option := range options {
+		option(&po)
+	}
+
+	analysis, error := stranalyzer.Analyze(s, stranalyzer.WithNegZero(po.negZero))
 
 	if error != nil {
 		return error
@@ -358,12 +399,12 @@ func (f *BigFloat) SetString(s string) error {
 }
 
 /*
-Create new BigFloat number from string
+Create new BigFloat number from string with ParseOption
 If parsing failed returns error
 */
-func SetString(s string) (*BigFloat, error) {
+func SetString(s string, options ...ParseOption) (*BigFloat, error) {
 	f := &BigFloat{}
-	err := f.SetString(s)
+	err := f.SetString(s, options...)
 
 	return f, err
 }
@@ -427,6 +468,11 @@ Divides two BigFloat numbers with DivOption:
 
 	decimalPlaces - target decimal places (default is -1 for detecting repeating decimals or remainder 0)
 	maxDecimalPlaces - safety parameter for the result of division with a very large number of decimal places (default is 1e4 - 10000)
+	roundingMode - rounding of last decimal with target decimal places (default is RoundHalfUp)
+	infOnZero - division by zero returns infinity instead of error (default is false)
+	conditions - variable where raised conditions are accumulated (default is nil)
+
+NaN and infinity operands follow IEEE 754 rules (e.g. x / Inf = 0, Inf / Inf = NaN).
 */
 func (f *BigFloat) Div(a, b *BigFloat, options ...DivOption) (*BigFloat, int, error) {
 	r := &BigFloat{}
@@ -439,6 +485,10 @@ Integer division of two BigFloat numbers
 Returns integer division and modulus
 */
 func (f *BigFloat) DivMod(a, b *BigFloat) (*BigFloat, *BigFloat, error) {
+	if err := checkFinite(a, b); err != nil {
+		return nil, nil, err
+	}
+
 	r := &BigFloat{}
 	_, _, err := f.divmod(a, b, r, true, WithDivDecimalPlaces(0))
 
@@ -460,26 +510,43 @@ func (f *BigFloat) divmod(a, b, remainder *BigFloat, bTrunc bool, options ...Div
 		option(&ro)
 	}
 
+	if r, ok := divSpecial(a, b, ro.infOnZero); ok { // NaN, infinity or negative zero
+		if r.IsNaN() && !a.IsNaN() && !b.IsNaN() {
+			report(ro.conditions, InvalidOperation)
+		} else if r.IsInf(0) && !a.IsInf(0) {
+			report(ro.conditions, DivisionByZero)
+		}
+		f.analysis = r.analysis
+		if ro.decimalPlaces >= 0 && f.IsInt64(0) {
+			f.SetDecimals(ro.decimalPlaces)
+		}
+		return f, 0, nil
+	}
+
 	if a.IsInt64(0) { // if 1st operand is 0 then result is 0
 		f.SetInt64(0)
+		remainder.SetInt64(0)
 		if ro.decimalPlaces >= 0 {
 			f.SetDecimals(ro.decimalPlaces)
 		}
 		return f, 0, nil
 	} else if b.IsInt64(0) { // if 2nd operand is 0 then return error
+		report(ro.conditions, DivisionByZero)
 		return nil, 0, fmt.Errorf("ERROR: Division by zero")
 	}
 
+	a, b = a.expanded(), b.expanded()
 	aCopy := a.Copy().Abs() // prepare copies of both operands with absolute values
 	bCopy := b.Copy().Abs()
 
 	if (a.analysis.Decimals + b.analysis.Decimals) > 0 { // eliminate decimals in both operands
-		aCopy.Mul10(a.analysis.Decimals + b.analysis.Decimals)
-		bCopy.Mul10(a.analysis.Decimals + b.analysis.Decimals)
+		aCopy.Mul10(a.analysis.Decimals + b.analysis.Decimals).expand()
+		bCopy.Mul10(a.analysis.Decimals + b.analysis.Decimals).expand()
 	}
 
 	if bCopy.IsInt64(1) { // if 2nd operand is 1 then result is 1st operand
 		f.analysis = aCopy.analysis
+		remainder.SetInt64(0)
 		f.Sign(a.analysis.Sign * b.analysis.Sign) // sign of result
 
 		if ro.decimalPlaces >= 0 {
@@ -531,7 +598,12 @@ func (f *BigFloat) divmod(a, b, remainder *BigFloat, bTrunc bool, options ...Div
 			digit = aBuf[i]
 		}
 
-		if (bDecimals && string(divPart) == "0") || (decimalsGoal > 0 && decimals == decimalsGoal) { // exit loop if calculates beyond decimal point and there is no remainder or if target decimals reached
+		if bDecimals && string(divPart) == "0" { // exit loop if calculates beyond decimal point and there is no remainder
+			if decimals == 0 { // exact integer division has no remainder
+				lastRemainder.SetInt64(0)
+			}
+			break
+		} else if decimalsGoal > 0 && decimals == decimalsGoal { // exit loop if target decimals reached
 			break
 		}
 
@@ -625,6 +697,14 @@ func (f *BigFloat) divmod(a, b, remainder *BigFloat, bTrunc bool, options ...Div
 		decimals = decimalsGoal
 	}
 
+	sticky := false // non zero remainder after last calculated digit
+	for _, d := range divPart {
+		if d != '0' {
+			sticky = true
+			break
+		}
+	}
+
 	f.analysis = stranalyzer.Analysis{ // create division result
 		Norm:     result,
 		Len:      len(result),
@@ -636,11 +716,16 @@ func (f *BigFloat) divmod(a, b, remainder *BigFloat, bTrunc bool, options ...Div
 		if bTrunc { // in case of integer division, decimals are truncated
 			f.Trunc(WithDecimalPlaces(0))
 		} else { // round penultimate digit
-			f.Round(ro.decimalPlaces)
+			cond := f.roundCond(ro.decimalPlaces, ro.roundingMode, sticky)
+			if cond&Inexact != 0 { // zeroes after exact result are not rounded digits
+				report(ro.conditions, cond)
+			}
 			f.SetDecimals(ro.decimalPlaces)
 		}
 		*remainder = *lastRemainder                                // prepare out arg as remainder
 		remainder.Div10(a.analysis.Decimals + b.analysis.Decimals) // fix decimals in remainder
+	} else if sticky && repDecimalsInd < 0 && !bTrunc { // division stopped at maximum decimal places
+		report(ro.conditions, Inexact|Rounded)
 	}
 
 	return f, repeatDecimals, nil
@@ -648,8 +733,21 @@ func (f *BigFloat) divmod(a, b, remainder *BigFloat, bTrunc bool, options ...Div
 
 /*
 Multiplication of two BigFLoat numbers
+MulOption defines scale policy of product (default is ScaleUnbounded - sum of decimal places of operands)
 */
-func (f *BigFloat) Mul(a, b *BigFloat) *BigFloat {
+func (f *BigFloat) Mul(a, b *BigFloat, options ...MulOption) *BigFloat {
+	if mo := mulOptions(options); mo.scale.kind != scaleUnbounded {
+		decimals := maxInt(a.analysis.Decimals, b.analysis.Decimals) // before f is changed (f can be operand)
+
+		return mo.scale.apply(f.Mul(a, b), decimals)
+	}
+
+	if r, ok := mulSpecial(a, b); ok { // NaN, infinity or negative zero
+		f.analysis = r.analysis
+
+		return f
+	}
+
 	if a.IsInt64(0) || b.IsInt64(0) { // check for 0
 		newDecimals := maxInt(a.analysis.Decimals, b.analysis.Decimals)
 
@@ -672,6 +770,17 @@ func (f *BigFloat) Mul(a, b *BigFloat) *BigFloat {
 		return f.Neg()
 	}
 
+	if a.isCompact() || b.isCompact() { // multiply coefficients and add exponents
+		ac, ae := a.coefficient()
+		bc, be := b.coefficient()
+		f.analysis = stranalyzer.Shift(New().Mul(ac, bc).analysis, ae+be)
+		if !f.isCompact() {
+			f.trimDecimals() // as in multiplication of digits
+		}
+
+		return f
+	}
+
 	var r, overflow int        // running multiplication result
 	var resultBuf []subProduct // sub products with offsets
 	aStr := a.analysis.Norm
@@ -770,6 +879,10 @@ func (f *BigFloat) Mul(a, b *BigFloat) *BigFloat {
 Returns if BigFloat equals number n
 */
 func (f *BigFloat) IsInt64(n int64) bool {
+	if f.isSpecial() || f.isCompact() { // NaN and infinity are not numbers, compact form is out of int64 range or not integer
+		return false
+	}
+
 	nFloat := BigFloat{}
 	nFloat.SetInt64(n).SetDecimals(f.analysis.Decimals)
 
@@ -783,6 +896,13 @@ func (f *BigFloat) IsInt64(n int64) bool {
 Truncates integer part of number and returns decimals only
 */
 func (f *BigFloat) Frac() *BigFloat {
+	if f.isSpecial() { // fractional part of infinity is NaN (see math.Modf)
+		f.analysis = NaN().analysis
+
+		return f
+	}
+
+	f.expand()
 	if (f.analysis.Len - f.analysis.Decimals) > 0 {
 		f.analysis.Norm = append([]byte{'0'}, f.analysis.Norm[f.analysis.Len-f.analysis.Decimals:]...)
 		f.analysis.Len = len(f.analysis.Norm)
@@ -799,12 +919,30 @@ func (f *BigFloat) Frac() *BigFloat {
 
 /*
 Multiply BigFloat with int64
+MulOption defines scale policy of product (default is ScaleUnbounded)
 */
-func (f *BigFloat) MulInt64(n int64) *BigFloat {
+func (f *BigFloat) MulInt64(n int64, options ...MulOption) *BigFloat {
+	if mo := mulOptions(options); mo.scale.kind != scaleUnbounded {
+		decimals := f.analysis.Decimals
+
+		return mo.scale.apply(f.MulInt64(n), decimals)
+	}
+
+	if f.analysis.Special != stranalyzer.Finite { // NaN, infinity or negative zero (e.g. Inf * 0 = NaN)
+		r, _ := mulSpecial(f, SetInt64(n))
+		f.analysis = r.analysis
+
+		return f
+	}
+
 	if n == 0 { // result is 0 with a predefined number of decimals
-		f.analysis.Sign = 1
-		f.analysis.Norm = fill(f.analysis.Decimals+1, '0')
-		f.analysis.Len = f.analysis.Decimals + 1
+		decimals := f.decimals()
+		f.analysis = stranalyzer.Analysis{
+			Norm:     fill(decimals+1, '0'),
+			Len:      decimals + 1,
+			Decimals: decimals,
+			Sign:     1,
+		}
 
 		return f
 	} else if n == 1 { // same BigFloat as result
@@ -837,11 +975,13 @@ func (f *BigFloat) MulInt64(n int64) *BigFloat {
 
 /*
 Truncate decimals in BigFloat number
-RoundOption defines number of decimals in result
+RoundOption defines number of decimals in result and rounding mode of whole number (default is RoundDown)
 */
 func (f *BigFloat) Trunc(options ...RoundOption) *BigFloat {
+	f.expand()
 	ro := roundOptionsType{
 		decimalPlaces: f.analysis.Decimals,
+		roundingMode:  RoundDown,
 	}
 	for _, option := range options {
 		option(&ro)
@@ -851,10 +991,7 @@ func (f *BigFloat) Trunc(options ...RoundOption) *BigFloat {
 		panic("ERROR: Negative decimal places. Decimal places should be 0 or positive")
 	}
 
-	for i := f.analysis.Len - f.analysis.Decimals; i < f.analysis.Len; i++ { // set '0' as decimals digits
-		f.analysis.Norm[i] = '0'
-	}
-
+	report(ro.conditions, f.roundCond(0, ro.roundingMode, false))
 	f.SetDecimals(ro.decimalPlaces)
 
 	return f
@@ -864,6 +1001,11 @@ func (f *BigFloat) Trunc(options ...RoundOption) *BigFloat {
 Set target decimals
 */
 func (f *BigFloat) SetDecimals(n int) *BigFloat {
+	if f.isCompact() && n == f.decimals() { // no need to expand
+		return f
+	}
+
+	f.expand()
 
 	if n == f.analysis.Decimals { // no need to change
 		f.Sign(f.analysis.Sign) // checks negative sign for 0 e.g. (-0.1).SetDecimals(0) => 0
@@ -947,6 +1089,12 @@ See following table for cases when operands are swapped and how result sign is s
 	|  8 |   5 |   8+5  |  no  |     abs bigger   |          8+5     |
 */
 func (f *BigFloat) Add(a, b *BigFloat) *BigFloat {
+	if r, ok := addSpecial(a, b); ok { // NaN, infinity or negative zero
+		f.analysis = r.analysis
+
+		return f
+	}
+
 	if a.IsInt64(0) { // if 1st operand is 0 then result is 2nd operand (0 + B = B)
 		f.analysis = b.analysis
 
@@ -957,6 +1105,13 @@ func (f *BigFloat) Add(a, b *BigFloat) *BigFloat {
 		return f
 	}
 
+	if a.isCompact() || b.isCompact() { // addition of coefficients aligned to smaller exponent
+		ac, bc, e := alignedCoefficients(a, b)
+		f.analysis = stranalyzer.Shift(New().Add(ac, bc).analysis, e) // compact form of result if needed
+
+		return f
+	}
+
 	if a.analysis.Sign == b.analysis.Sign { // if both operands have same signs call internal add
 		return f.add(a, b)
 	} else { // opposite signs
@@ -1049,6 +1204,14 @@ See following table for cases when operands are swapped and how result sign is s
 	|  8 |   5 |   8-5  |  no  |        1st       |       8-5        |
 */
 func (f *BigFloat) Sub(a, b *BigFloat) *BigFloat {
+	if a.analysis.Special != stranalyzer.Finite || b.analysis.Special != stranalyzer.Finite { // NaN, infinity or negative zero
+		if r, ok := addSpecial(a, negated(b)); ok {
+			f.analysis = r.analysis
+
+			return f
+		}
+	}
+
 	if a.IsInt64(0) { // if 1st operand is 0 then result is opposite 2nd operand (0 - B = -B)
 		f.analysis = b.analysis
 
@@ -1058,6 +1221,14 @@ func (f *BigFloat) Sub(a, b *BigFloat) *BigFloat {
 
 		return f
 	}
+
+	if a.isCompact() || b.isCompact() { // subtraction of coefficients aligned to smaller exponent
+		ac, bc, e := alignedCoefficients(a, b)
+		f.analysis = stranalyzer.Shift(New().Sub(ac, bc).analysis, e) // compact form of result if needed
+
+		return f
+	}
+
 	f1, f2 := a.Copy(), b.Copy()
 	if a.analysis.Sign != b.analysis.Sign { // if operands have opposite signs
 		sign := f1.analysis.Sign
@@ -1127,6 +1298,12 @@ func (f *BigFloat) Sign(s int) *BigFloat {
 Sets opposite sign, except for 0
 */
 func (f *BigFloat) Neg() *BigFloat {
+	if f.analysis.Special == stranalyzer.NegZero {
+		f.analysis.Special = stranalyzer.Finite
+
+		return f
+	}
+
 	f.Sign(f.analysis.Sign * -1)
 
 	return f
@@ -1136,6 +1313,10 @@ func (f *BigFloat) Neg() *BigFloat {
 Returns aboslute value of BigFloat number
 */
 func (f *BigFloat) Abs() *BigFloat {
+	if f.analysis.Special == stranalyzer.NegZero {
+		f.analysis.Special = stranalyzer.Finite
+	}
+
 	return f.Sign(1)
 }
 
@@ -1144,6 +1325,8 @@ Compares two BigFloat numbers and returns:
 -1 if 1st number is smaller then 2nd
 0 if 1st number is equal to 2nd
 1 if 1st number is bigger then 2nd
+
+Negative zero is equal to 0. NaN is equal to NaN and bigger then any other number (including +Inf), so use IsNaN for IEEE 754 unordered comparison.
 */
 func (f *BigFloat) Compare(a *BigFloat) int {
 	return f.compare(a, false)
@@ -1163,6 +1346,28 @@ func (f *BigFloat) CompareAbs(a *BigFloat) int {
 Internal method for comparing two BigFloat numbers
 */
 func (f *BigFloat) compare(a *BigFloat, abs bool) int {
+	if f.isSpecial() || a.isSpecial() {
+		return compareSpecial(f, a, abs)
+	}
+
+	if f.isCompact() || a.isCompact() {
+		sign := 1
+		if !abs {
+			if f.analysis.Sign != a.analysis.Sign {
+				return f.analysis.Sign
+			}
+			sign = f.analysis.Sign
+		}
+		switch { // compact form is never 0
+		case f.IsInt64(0):
+			return -sign
+		case a.IsInt64(0):
+			return sign
+		}
+
+		return sign * compareMagnitude(f, a)
+	}
+
 	if abs || (f.analysis.Sign == a.analysis.Sign) { // if signs are same or signs are ignored in case of abs == true
 		n := []*BigFloat{f, a}
 		alignment := align(n...) // calculate decimals aligment
@@ -1190,29 +1395,16 @@ func (f *BigFloat) compare(a *BigFloat, abs bool) int {
 
 /*
 Rounds number to n decimals
+RoundOption defines rounding mode (default is RoundHalfUp) and variable for raised conditions
 */
-func (f *BigFloat) Round(n int) *BigFloat {
-	if n < 0 {
-		panic("Invalid decimal number")
-	}
-	if n < f.analysis.Decimals { // if n decimal for rounding exists
-		pos := f.analysis.Len - f.analysis.Decimals + n // posistion of digit for rounding
-		d := f.analysis.Norm[pos]                       // digit for rounding
-		f.analysis.Len -= f.analysis.Decimals - n       // fix the length
-		f.analysis.Decimals = n                         // fix decimals
-		//digits := fill(f.analysis.Len-pos, 48)          // zeroes after rounding digit
-		f.analysis.Norm = f.analysis.Norm[:pos]
-
-		if d >= '5' { // rounding up
-			c := BigFloat{}        // create new BigFloat number
-			c.SetInt64(1).Div10(n) // with rounding digit
-			c.Sign(f.GetSign())
-			f.Add(f, &c) // calculate new number with addition
-		}
-
-		f.Sign(f.analysis.Sign)
+func (f *BigFloat) Round(n int, options ...RoundOption) *BigFloat {
+	ro := roundOptionsType{}
+	for _, option := range options {
+		option(&ro)
 	}
 
+	report(ro.conditions, f.roundCond(n, ro.roundingMode, false))
+
 	return f
 }
 
@@ -1223,6 +1415,9 @@ func (f *BigFloat) Pow10(n int) (*BigFloat, error) {
 	if n < 0 { // support for negative Pow10
 		f.SetInt64(1).Div10(-n)
 		return f, nil
+	} else if n > stranalyzer.MaxPadding {
+		f.SetInt64(1).Mul10(n)
+		return f, nil
 	}
 
 	zeroes := fill(n+1, 48) // '1' + n zeroes
@@ -1239,45 +1434,21 @@ func (f *BigFloat) Pow10(n int) (*BigFloat, error) {
 
 /*
 Multiply BigFloat number with 10 multiplicator
+Leading zeroes of whole number part are removed (e.g. 0.05 multiplied with 10 is 0.5, not 00.5)
+Very large numbers are kept in compact form (see stranalyzer.MaxPadding)
 */
 func (f *BigFloat) Mul10(n int) *BigFloat {
-	if (f.analysis.Decimals - n) > 0 { // if there is enough decimals for multiplication
-		f.analysis.Decimals -= n // just fix decimals
-	} else {
-		zeroes := fill(n-f.analysis.Decimals, '0')           // prepare yeroes
-		f.analysis.Norm = append(f.analysis.Norm, zeroes...) // prepend zeroes
-		f.analysis.Len += n - f.analysis.Decimals
-		f.analysis.Decimals = 0
-
-		// trim leading zeroes
-		iTrim := 0
-		for i := 0; i < f.analysis.Len-f.analysis.Decimals; i++ {
-			if f.analysis.Norm[i] == 48 {
-				iTrim++
-			} else {
-				break
-			}
-		}
-		if iTrim > 0 {
-			f.analysis.Norm = f.analysis.Norm[iTrim:]
-			f.analysis.Len -= iTrim
-		}
-
-	}
+	f.analysis = stranalyzer.Shift(f.analysis, n)
 
 	return f
 }
 
 /*
 Divides BigFloat number with 10 multiplicator
+Very small numbers are kept in compact form (see stranalyzer.MaxPadding)
 */
 func (f *BigFloat) Div10(n int) *BigFloat {
-	if (f.analysis.Decimals + n) >= f.analysis.Len { // need to prepend zeroes
-		zeroes := fill(n-(f.analysis.Len-f.analysis.Decimals)+1, '0')
-		f.analysis.Norm = append(zeroes, f.analysis.Norm...)
-		f.analysis.Len += len(zeroes)
-	}
-	f.analysis.Decimals += n
+	f.analysis = stranalyzer.Shift(f.analysis, -n)
 
 	return f
 }
@@ -1316,7 +1487,9 @@ See: StringF
 type StringOption func(*stringOptionType)
 
 type stringOptionType struct {
-	forceSign bool
+	forceSign  bool
+	signedZero bool
+	scientific bool
 }
 
 /*
@@ -1330,6 +1503,30 @@ func ForceSign(forceSign bool) StringOption {
 	}
 }
 
+/*
+Function defines if negative zero is formatted with '-' sign or not.
+
+See: StringF
+*/
+func SignedZero(signedZero bool) StringOption {
+	return func(so *stringOptionType) {
+		so.signedZero = signedZero
+	}
+}
+
+/*
+Function defines if number is formatted in scientific notation (e.g. 1.5E-1000000) or with all digits.
+Scientific notation has significant digits only (e.g. 1200 is 1.2E+3).
+Repeating decimals in StringF are indicated in coefficient (e.g. 1/70 is 1.(428571)E-2).
+
+See: StringF
+*/
+func Scientific(scientific bool) StringOption {
+	return func(so *stringOptionType) {
+		so.scientific = scientific
+	}
+}
+
 /*
 Returns string
 Optional arg is forceSign for 0 or positive number to force '+' sign
@@ -1358,9 +1555,17 @@ func (f *BigFloat) StringF(RepeatingDecimals int, options ...interface{}) string
 		}
 	}
 
+	so := stringOptionType{}
+	for _, option := range strOptions {
+		option(&so)
+	}
+	if so.scientific && RepeatingDecimals > 0 && !f.isSpecial() {
+		return f.scientificRepeating(RepeatingDecimals, ro, so)
+	}
+
 	result := f.StringWith(strOptions...)
 
-	if RepeatingDecimals > 0 {
+	if RepeatingDecimals > 0 && !f.isSpecial() { // NaN and infinity have no decimals
 		var b strings.Builder
 		b.Grow(len(result) + len(ro.indicatorStart) + len(ro.indicatorEnd))
 
@@ -1372,9 +1577,48 @@ func (f *BigFloat) StringF(RepeatingDecimals int, options ...interface{}) string
 	return result
 }
 
+/*
+For internal use
+Formats number with repeating decimals in scientific notation with repeating indicator in coefficient
+Repeating decimals before the first significant digit are rotated (e.g. 0.0(142857) is 1.(428571)E-2 and 0.(09) is 9.(09)E-2)
+*/
+func (f *BigFloat) scientificRepeating(repeatingDecimals int, ro repeatingOptionsType, so stringOptionType) string {
+	e := f.expanded()
+	norm := string(e.analysis.Norm[:e.analysis.Len])
+
+	first := 0 // first significant digit
+	for first < len(norm)-1 && norm[first] == '0' {
+		first++
+	}
+	exponent := e.analysis.Len - e.analysis.Decimals - 1 - first
+
+	start := len(norm) - repeatingDecimals // first repeating digit
+	fixed, repeating := "", ""
+	if first < start {
+		fixed, repeating = norm[first+1:start], norm[start:]
+	} else {
+		repeating = norm[first+1:] + norm[start:first+1]
+	}
+
+	var b strings.Builder
+	if e.analysis.Sign == -1 {
+		fmt.Fprintf(&b, "%c", '-')
+	} else if so.forceSign {
+		fmt.Fprintf(&b, "%c", '+')
+	}
+	fmt.Fprintf(&b, "%c.%s%s%s%sE%+d", norm[first], fixed, ro.indicatorStart, repeating, ro.indicatorEnd, exponent)
+
+	return b.String()
+}
+
 /*
 Returns string with formatting options:
 -forceSign bool - if true then forces '+' sign for positive numbers
+-signedZero bool - if true then formats negative zero with '-' sign
+-scientific bool - if true then formats number in scientific notation
+
+NaN and infinity are formatted as "NaN", "Inf" and "-Inf".
+Without scientific notation all digits are formatted, also for very large and very small numbers (e.g. 1e1000000).
 */
 func (f *BigFloat) StringWith(options ...StringOption) string {
 	so := stringOptionType{
@@ -1387,16 +1631,44 @@ func (f *BigFloat) StringWith(options ...StringOption) string {
 	var b strings.Builder
 	b.Grow(f.analysis.Len + 2)
 
-	if f.analysis.Sign == -1 {
+	if f.IsNaN() {
+		return "NaN"
+	}
+
+	if f.analysis.Sign == -1 || (so.signedZero && f.analysis.Special == stranalyzer.NegZero) {
 		fmt.Fprintf(&b, "%c", '-')
 	} else if so.forceSign && !f.IsInt64(0) {
 		fmt.Fprintf(&b, "%c", '+')
 	}
 
-	fmt.Fprintf(&b, "%s", f.analysis.Norm[:f.analysis.Len-f.analysis.Decimals])
+	if f.IsInf(0) {
+		fmt.Fprintf(&b, "Inf")
+
+		return b.String()
+	}
+
+	if so.scientific { // significant digits and exponent of first digit (e.g. 1.5E-1000000)
+		digits := bytes.TrimLeft(f.analysis.Norm, "0")
+		exponent := len(digits) - f.analysis.Decimals - 1 + f.analysis.Exponent
+		if digits = bytes.TrimRight(digits, "0"); len(digits) == 0 { // zero
+			digits, exponent = []byte{'0'}, 0
+		}
+
+		fmt.Fprintf(&b, "%c", digits[0])
+		if len(digits) > 1 {
+			fmt.Fprintf(&b, ".%s", digits[1:])
+		}
+		fmt.Fprintf(&b, "E%+d", exponent)
+
+		return b.String()
+	}
+
+	e := f.expanded() // compact form is only internal representation
+
+	fmt.Fprintf(&b, "%s", e.analysis.Norm[:e.analysis.Len-e.analysis.Decimals])
 
-	if f.analysis.Decimals > 0 {
-		fmt.Fprintf(&b, ".%s", f.analysis.Norm[f.analysis.Len-f.analysis.Decimals:])
+	if e.analysis.Decimals > 0 {
+		fmt.Fprintf(&b, ".%s", e.analysis.Norm[e.analysis.Len-e.analysis.Decimals:])
 	}
 
 	return b.String()
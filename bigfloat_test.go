@@ -10,19 +10,19 @@ func toString(f int64) string {
 	return strconv.FormatInt(f, 10)
 }
 
-func createBigFloat(t *testing.T, s string) (*BigFloat, error) {
+func createBigFloat(t *testing.T, s string, options ...ParseOption) (*BigFloat, error) {
 	n := &BigFloat{}
-	err := n.SetString(s)
+	err := n.SetString(s, options...)
 	if err != nil && t != nil {
 		t.Errorf("ERROR: %q is not valid big float number\n", s)
 	}
 	return n, err
 }
 
-func create2BigFloats(t *testing.T, s1, s2 string) (*BigFloat, *BigFloat, error) {
-	n1, err := createBigFloat(t, s1)
+func create2BigFloats(t *testing.T, s1, s2 string, options ...ParseOption) (*BigFloat, *BigFloat, error) {
+	n1, err := createBigFloat(t, s1, options...)
 	if err == nil {
-		n2, err := createBigFloat(t, s2)
+		n2, err := createBigFloat(t, s2, options...)
 		return n1, n2, err
 	}
 	return nil, nil, err
@@ -177,6 +177,10 @@ func TestDivMod(t *testing.T) {
 		{"43", "-22", "-1", "21"},
 		{"-43", "-22", "1", "21"},
 		{"-43", "22", "-1", "21"},
+		{"12", "6", "2", "0"},
+		{"7.5", "2.5", "3", "0.00"},
+		{"7", "1", "7", "0"},
+		{"0", "5", "0", "0"},
 	}
 	fmt.Printf("\nTestDivMod...\n")
 	for _, c := range cases {
@@ -408,6 +412,9 @@ func TestFrac(t *testing.T) {
 		{"-800.01", "-0.01"},
 		{"-800.0", "0.0"},
 		{"-800", "0"},
+		{"Inf", "NaN"},
+		{"-Inf", "NaN"},
+		{"NaN", "NaN"},
 	}
 	fmt.Printf("\nTestFrac...\n")
 	for _, c := range cases {
@@ -782,22 +789,27 @@ func TestMul10(t *testing.T) {
 		{"-800.01", 1, "-8000.1"},
 		{"-800.01", 2, "-80001"},
 		{"-800.01", 3, "-800010"},
+		{"0.00123", 2, "0.123"},
+		{"0.00123", 3, "1.23"},
+		{"0.05", 1, "0.5"},
+		{"-0.05", 1, "-0.5"},
+		{"0.5", 1, "5"},
+		{"0", 2, "0"},
+		{"0.000", 2, "0.0"},
 	}
 	fmt.Printf("\nTestMul10...\n")
 	for _, c := range cases {
 		fmt.Printf("mul10(%v, %v) = ", c.param1, c.param2)
-		n1, err := createBigFloat(t, c.param1)
+		n1, n2, err := create2BigFloats(t, c.param1, c.expected)
 		if err != nil {
 			continue
 		}
 
 		n1.Mul10(c.param2)
 
-		expectedStr := c.expected
-		result := n1.String()
-
+		result := fmt.Sprintf("%v %v %v %s", n1, n1.analysis.Len, n1.analysis.Decimals, n1.analysis.Norm) // the same digits as parsed number
 		fmt.Printf("%v\n", result)
-		printResult(t, result, expectedStr, nil)
+		printResult(t, result, fmt.Sprintf("%v %v %v %s", n2, n2.analysis.Len, n2.analysis.Decimals, n2.analysis.Norm), nil)
 	}
 }
 
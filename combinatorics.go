@@ -0,0 +1,83 @@
+/*
+Copyright 2023 Tihomir Magdic. All rights reserved.
+Use of this source code is governed by a BSD-style
+license that can be found in the LICENSE file.
+*/
+
+package bigfloat
+
+import (
+	"fmt"
+)
+
+/*
+For internal use
+Product of all integers from lo to hi (product tree - operands of every multiplication have similar number of digits)
+*/
+func natProduct(lo, hi uint64) nat {
+	if lo > hi {
+		return nat{1}
+	} else if hi-lo < 4 { // short range is multiplied directly
+		p := natUint64(lo)
+		for i := lo + 1; i <= hi; i++ {
+			p = natMul(p, natUint64(i))
+		}
+		return p
+	}
+
+	mid := lo + (hi-lo)/2
+
+	return natMul(natProduct(lo, mid), natProduct(mid+1, hi))
+}
+
+/*
+Creates new BigFloat number with factorial n! = 1 * 2 * ... * n (0! = 1)
+Returns error for negative n
+*/
+func Factorial(n int64) (*BigFloat, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("ERROR: Negative argument of factorial")
+	}
+
+	return natProduct(1, uint64(n)).bigFloat(), nil
+}
+
+/*
+Creates new BigFloat number with number of permutations of k elements from n elements n! / (n - k)! = (n - k + 1) * ... * n
+Result is 0 for k > n
+Returns error for negative n or k
+*/
+func Permutations(n, k int64) (*BigFloat, error) {
+	if n < 0 || k < 0 {
+		return nil, fmt.Errorf("ERROR: Negative argument of permutations")
+	}
+
+	if k > n {
+		return New(), nil
+	}
+
+	return natProduct(uint64(n-k+1), uint64(n)).bigFloat(), nil
+}
+
+/*
+Creates new BigFloat number with binomial coefficient (number of combinations) n! / (k! * (n - k)!)
+Result is 0 for k > n
+Returns error for negative n or k
+*/
+func Binomial(n, k int64) (*BigFloat, error) {
+	if n < 0 || k < 0 {
+		return nil, fmt.Errorf("ERROR: Negative argument of binomial coefficient")
+	}
+
+	if k > n {
+		return New(), nil
+	}
+
+	if k > n-k { // C(n, k) = C(n, n - k)
+		k = n - k
+	}
+
+	q, _ := natDivMod(natProduct(uint64(n-k+1), uint64(n)), natProduct(1, uint64(k))) // exact division
+
+	return q.bigFloat(), nil
+}
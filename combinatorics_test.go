@@ -0,0 +1,107 @@
+package bigfloat
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestFactorial(t *testing.T) {
+	var cases = []struct {
+		param    int64
+		expected string
+	}{
+		{0, "1"},
+		{1, "1"},
+		{5, "120"},
+		{20, "2432902008176640000"},
+		{25, "15511210043330985984000000"},
+		{100, "93326215443944152681699238856266700490715968264381621468592963895217599993229915608941463976156518286253697920827223758251185210916864000000000000000000000000"},
+	}
+	fmt.Printf("\nTestFactorial...\n")
+	for _, c := range cases {
+		fmt.Printf("factorial(%v) = ", c.param)
+		result, err := Factorial(c.param)
+		fmt.Printf("%v\n", result)
+		printResult(t, result.String(), c.expected, err)
+	}
+
+	result, err := Factorial(1000) // 2568 digits
+	s := result.String()
+	fmt.Printf("factorial(1000) = %v... (%d digits)\n", s[:20], len(s))
+	printResult(t, fmt.Sprintf("%v %d", s[:20], len(s)), "40238726007709377354 2568", err)
+
+	result, err = Factorial(5000) // 16326 digits with 1249 trailing zeroes (more than compact form threshold)
+	s = result.String()
+	trimmed := strings.TrimRight(s, "0")
+	fmt.Printf("factorial(5000) = %v...%v (%d digits, %d trailing zeroes)\n", s[:20], trimmed[len(trimmed)-10:], len(s), len(s)-len(trimmed))
+	printResult(t, fmt.Sprintf("%v %v %d %d", s[:20], trimmed[len(trimmed)-10:], len(s), len(s)-len(trimmed)), "42285779266055435222 0937833472 16326 1249", err)
+}
+
+func TestPermutations(t *testing.T) {
+	var cases = []struct {
+		param1   int64
+		param2   int64
+		expected string
+	}{
+		{10, 3, "720"},
+		{5, 5, "120"},
+		{5, 0, "1"},
+		{0, 0, "1"},
+		{3, 5, "0"},
+		{100, 50, "3068518756254966037202730459529469739228459721684688959447786986982158958772355072000000000000"},
+	}
+	fmt.Printf("\nTestPermutations...\n")
+	for _, c := range cases {
+		fmt.Printf("permutations(%v, %v) = ", c.param1, c.param2)
+		result, err := Permutations(c.param1, c.param2)
+		fmt.Printf("%v\n", result)
+		printResult(t, result.String(), c.expected, err)
+	}
+}
+
+func TestBinomial(t *testing.T) {
+	var cases = []struct {
+		param1   int64
+		param2   int64
+		expected string
+	}{
+		{5, 2, "10"},
+		{5, 3, "10"},
+		{52, 5, "2598960"},
+		{10, 0, "1"},
+		{10, 10, "1"},
+		{0, 0, "1"},
+		{3, 5, "0"},
+		{1000000000000, 2, "499999999999500000000000"},
+		{1000, 500, "270288240945436569515614693625975275496152008446548287007392875106625428705522193898612483924502370165362606085021546104802209750050679917549894219699518475423665484263751733356162464079737887344364574161119497604571044985756287880514600994219426752366915856603136862602484428109296905863799821216320"},
+	}
+	fmt.Printf("\nTestBinomial...\n")
+	for _, c := range cases {
+		fmt.Printf("binomial(%v, %v) = ", c.param1, c.param2)
+		result, err := Binomial(c.param1, c.param2)
+		fmt.Printf("%v\n", result)
+		printResult(t, result.String(), c.expected, err)
+	}
+}
+
+func TestErrorsCombinatorics(t *testing.T) {
+	var cases = []struct {
+		name string
+		fn   func() error
+	}{
+		{"factorial(-1)", func() error { _, err := Factorial(-1); return err }},
+		{"permutations(-1, 2)", func() error { _, err := Permutations(-1, 2); return err }},
+		{"permutations(5, -2)", func() error { _, err := Permutations(5, -2); return err }},
+		{"binomial(-5, 2)", func() error { _, err := Binomial(-5, 2); return err }},
+		{"binomial(5, -1)", func() error { _, err := Binomial(5, -1); return err }},
+	}
+	fmt.Printf("\nTestErrorsCombinatorics...\n")
+	for _, c := range cases {
+		err := c.fn()
+		fmt.Printf("%v = %v\n", c.name, err)
+		if err == nil {
+			t.Errorf("%v should return error", c.name)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2023 Tihomir Magdic. All rights reserved.
+Use of this source code is governed by a BSD-style
+license that can be found in the LICENSE file.
+*/
+
+package bigfloat
+
+import (
+	"strings"
+)
+
+/*
+Conditions (flags) raised by operations (see General Decimal Arithmetic)
+Conditions are combined with bitwise or (e.g. Inexact | Rounded)
+*/
+type Condition uint
+
+const (
+	Inexact          Condition = 1 << iota // non zero digits are discarded
+	Rounded                                // digits are discarded (zero or non zero)
+	Clamped                                // exponent of result is changed to fit exponent limits (context has no exponent limits, so it is not raised)
+	Overflow                               // result exceeds digit limit
+	Underflow                              // non zero result is rounded to zero
+	DivisionByZero                         // division of non zero number by zero
+	InvalidOperation                       // operation result is NaN (e.g. 0 / 0, Inf - Inf, Inf * 0)
+)
+
+var conditionNames = []string{"Inexact", "Rounded", "Clamped", "Overflow", "Underflow", "DivisionByZero", "InvalidOperation"}
+
+/*
+Returns names of raised conditions separated with '|' (e.g. "Inexact|Rounded")
+*/
+func (c Condition) String() string {
+	if c == 0 {
+		return "None"
+	}
+
+	names := make([]string, 0, len(conditionNames))
+	for i, name := range conditionNames {
+		if c&(1<<i) != 0 {
+			names = append(names, name)
+		}
+	}
+
+	return strings.Join(names, "|")
+}
+
+/*
+Function defines variable where conditions raised in rounding are accumulated
+
+See: Round, Trunc, RoundSig
+*/
+func WithConditions(conditions *Condition) RoundOption {
+	return func(ro *roundOptionsType) {
+		ro.conditions = conditions
+	}
+}
+
+/*
+Function defines variable where conditions raised in division are accumulated
+
+See: Div
+*/
+func WithDivConditions(conditions *Condition) DivOption {
+	return func(ro *divOptionsType) {
+		ro.conditions = conditions
+	}
+}
+
+/*
+For internal use
+Accumulates conditions in variable (if defined)
+*/
+func report(conditions *Condition, c Condition) {
+	if conditions != nil {
+		*conditions |= c
+	}
+}
@@ -0,0 +1,235 @@
+package bigfloat
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestConditionString(t *testing.T) {
+	var cases = []struct {
+		cond     Condition
+		expected string
+	}{
+		{0, "None"},
+		{Inexact | Rounded, "Inexact|Rounded"},
+		{InvalidOperation, "InvalidOperation"},
+		{DivisionByZero | Clamped | Underflow, "Clamped|Underflow|DivisionByZero"},
+	}
+	fmt.Printf("\nTestConditionString...\n")
+	for _, c := range cases {
+		result := c.cond.String()
+		fmt.Printf("%v\n", result)
+		printResult(t, result, c.expected, nil)
+	}
+}
+
+func TestRoundConditions(t *testing.T) {
+	var cases = []struct {
+		op       string
+		param    string
+		decimals int
+		expected Condition
+	}{
+		{"round", "1.25", 1, Inexact | Rounded},
+		{"round", "1.20", 1, Rounded},
+		{"round", "1.2", 1, 0},
+		{"round", "1.2", 3, 0},
+		{"trunc", "-1.5", 0, Inexact | Rounded},
+		{"trunc", "7.000", 0, Rounded},
+		{"roundSig", "123.0", 3, Rounded},
+		{"roundSig", "123.4", 3, Inexact | Rounded},
+		{"roundSig", "123", 3, 0},
+	}
+	fmt.Printf("\nTestRoundConditions...\n")
+	for _, c := range cases {
+		fmt.Printf("%v(%v, %v) = ", c.op, c.param, c.decimals)
+		n1, err := createBigFloat(t, c.param)
+		if err != nil {
+			continue
+		}
+
+		var cond Condition
+		switch c.op {
+		case "round":
+			n1.Round(c.decimals, WithConditions(&cond))
+		case "trunc":
+			n1.Trunc(WithDecimalPlaces(c.decimals), WithConditions(&cond))
+		case "roundSig":
+			n1.RoundSig(c.decimals, WithConditions(&cond))
+		}
+
+		result := cond.String()
+		fmt.Printf("%v\n", result)
+		printResult(t, result, c.expected.String(), nil)
+	}
+}
+
+func TestDivConditions(t *testing.T) {
+	var cases = []struct {
+		param1   string
+		param2   string
+		options  []DivOption
+		expected Condition
+	}{
+		{"1", "3", []DivOption{WithDivDecimalPlaces(2)}, Inexact | Rounded},
+		{"1", "4", []DivOption{WithDivDecimalPlaces(2)}, 0},
+		{"1", "4", []DivOption{WithDivDecimalPlaces(5)}, 0},
+		{"1", "4", []DivOption{WithDivDecimalPlaces(1)}, Inexact | Rounded},
+		{"1", "3", nil, 0},
+		{"1", "97", []DivOption{WithDivMaxDecimalPlaces(10)}, Inexact | Rounded},
+		{"1", "0", []DivOption{WithDivInfOnZero(true)}, DivisionByZero},
+		{"0", "0", []DivOption{WithDivInfOnZero(true)}, InvalidOperation},
+		{"Inf", "Inf", nil, InvalidOperation},
+		{"NaN", "0", []DivOption{WithDivInfOnZero(true)}, 0},
+	}
+	fmt.Printf("\nTestDivConditions...\n")
+	for _, c := range cases {
+		fmt.Printf("div(%v, %v) = ", c.param1, c.param2)
+		n1, n2, err := create2BigFloats(t, c.param1, c.param2)
+		if err != nil {
+			continue
+		}
+
+		var cond Condition
+		n3 := New()
+		_, _, err = n3.Div(n1, n2, append(c.options, WithDivConditions(&cond))...)
+
+		result := cond.String()
+		fmt.Printf("%v\n", result)
+		printResult(t, result, c.expected.String(), err)
+	}
+
+	fmt.Printf("div(1, 0) = ")
+	var cond Condition
+	_, _, err := New().Div(SetInt64(1), SetInt64(0), WithDivConditions(&cond))
+	fmt.Printf("%v (%v)\n", cond, err)
+	if err == nil || cond != DivisionByZero {
+		t.Errorf("division by zero should return error and raise DivisionByZero")
+	}
+}
+
+func TestContextFlags(t *testing.T) {
+	var cases = []struct {
+		op       string
+		param1   string
+		param2   string
+		options  []ContextOption
+		expected Condition
+	}{
+		{"div", "1", "3", []ContextOption{WithPrecision(5)}, Inexact | Rounded},
+		{"div", "1", "800", []ContextOption{WithPrecision(5)}, 0},
+		{"add", "1", "2", []ContextOption{WithPrecision(5)}, 0},
+		{"add", "99999", "1", []ContextOption{WithPrecision(5)}, Rounded},
+		{"add", "1", "1", []ContextOption{WithScale(2)}, 0},
+		{"div", "1", "1000", []ContextOption{WithScale(2)}, Inexact | Rounded | Underflow},
+		{"mul", "0.001", "0.001", []ContextOption{WithPrecision(3)}, 0},
+		{"sub", "Inf", "Inf", []ContextOption{}, InvalidOperation},
+		{"div", "5", "0", []ContextOption{}, DivisionByZero},
+		{"mul", "1000", "1000", []ContextOption{WithContextMaxDigits(4)}, Overflow},
+	}
+	fmt.Printf("\nTestContextFlags...\n")
+	for _, c := range cases {
+		fmt.Printf("%v(%v, %v) = ", c.op, c.param1, c.param2)
+		n1, n2, err := create2BigFloats(t, c.param1, c.param2)
+		if err != nil {
+			continue
+		}
+
+		ctx, err := NewContext(c.options...)
+		if err != nil {
+			t.Errorf("%v", err)
+			continue
+		}
+
+		n3 := New()
+		switch c.op {
+		case "add":
+			ctx.Add(n3, n1, n2)
+		case "sub":
+			ctx.Sub(n3, n1, n2)
+		case "mul":
+			ctx.Mul(n3, n1, n2)
+		case "div":
+			ctx.Div(n3, n1, n2)
+		}
+
+		result := ctx.Flags().String()
+		fmt.Printf("%v\n", result)
+		printResult(t, result, c.expected.String(), nil)
+	}
+}
+
+func TestContextAccumulatedFlags(t *testing.T) {
+	fmt.Printf("\nTestContextAccumulatedFlags...\n")
+	ctx, err := NewContext(WithScale(2))
+	if err != nil {
+		t.Errorf("%v", err)
+		return
+	}
+
+	n1, n2, err := create2BigFloats(t, "1", "3")
+	if err != nil {
+		return
+	}
+	ctx.Div(New(), n1, n2)
+	ctx.Add(New(), n1, n2)
+	ctx.Div(New(), n1, New())
+
+	result := ctx.Flags().String()
+	fmt.Printf("flags = %v\n", result)
+	printResult(t, result, (Inexact | Rounded | DivisionByZero).String(), nil)
+
+	ctx.ClearFlags()
+	result = ctx.Flags().String()
+	fmt.Printf("cleared flags = %v\n", result)
+	printResult(t, result, "None", nil)
+}
+
+func TestContextTraps(t *testing.T) {
+	var cases = []struct {
+		param1   string
+		param2   string
+		expected string
+		trapped  bool
+	}{
+		{"1", "4", "0.25", false},
+		{"1", "3", "7", true},
+		{"2", "8", "0.25", false},
+		{"0.001", "1", "7", true},
+	}
+	fmt.Printf("\nTestContextTraps...\n")
+	ctx, err := NewContext(WithScale(2), WithTraps(Inexact))
+	if err != nil {
+		t.Errorf("%v", err)
+		return
+	}
+	for _, c := range cases {
+		fmt.Printf("div(%v, %v) = ", c.param1, c.param2)
+		n1, n2, err := create2BigFloats(t, c.param1, c.param2)
+		if err != nil {
+			continue
+		}
+
+		n3 := SetInt64(7) // not changed by trapped operation
+		_, err = ctx.Div(n3, n1, n2)
+
+		expectedStr := fmt.Sprintf("%v (trapped: %v)", c.expected, c.trapped)
+		result := fmt.Sprintf("%v (trapped: %v)", n3.String(), err != nil)
+
+		fmt.Printf("%v\n", result)
+		printResult(t, result, expectedStr, nil)
+	}
+
+	result := ctx.Flags().String()
+	fmt.Printf("flags = %v\n", result)
+	printResult(t, result, (Inexact | Rounded | Underflow).String(), nil)
+
+	fmt.Printf("div(1, 0) = ")
+	ctx, _ = NewContext(WithTraps(DivisionByZero))
+	_, err = ctx.Div(New(), SetInt64(1), New())
+	fmt.Printf("%v\n", err)
+	if err == nil || !strings.Contains(err.Error(), "Trapped condition") {
+		t.Errorf("trapped division by zero should return trap error")
+	}
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2023 Tihomir Magdic. All rights reserved.
+Use of this source code is governed by a BSD-style
+license that can be found in the LICENSE file.
+*/
+
+package bigfloat
+
+import (
+	"sync"
+)
+
+/*
+For internal use
+Cached mathematical constant
+
+Value is calculated once with compute function. Request for more decimals refines cached value with Newton's iterations (refine function),
+so every iteration doubles number of correct decimals. Cached decimals are always doubled (also when less decimals are requested),
+so slowly ascending requests (e.g. pi for 400 and then 401 decimals) don't refine cached value every time.
+Request for less decimals is served by rounding cached value.
+*/
+type constant struct {
+	mu       sync.Mutex
+	value    *BigFloat                                 // cached value
+	decimals int                                       // absolute error of cached value is less then 10^-decimals
+	compute  func(decimals int) *BigFloat              // calculation from scratch
+	refine   func(x *BigFloat, decimals int) *BigFloat // one Newton's iteration
+}
+
+/*
+For internal use
+Returns copy of constant with absolute error less then 10^-decimals
+*/
+func (c *constant) get(decimals int) *BigFloat {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.value == nil {
+		c.value = c.compute(decimals)
+		c.decimals = decimals
+	}
+
+	for c.decimals < decimals { // extend cached value geometrically
+		next := maxInt(2*c.decimals, c.decimals+1)
+		c.value = c.refine(c.value, next)
+		c.decimals = next
+	}
+
+	return c.value.clone()
+}
+
+/*
+For internal use
+Returns constant rounded to decimals
+*/
+func (c *constant) rounded(decimals int) *BigFloat {
+	if decimals < 0 {
+		panic("ERROR: Negative decimal places. Decimal places should be 0 or positive")
+	}
+
+	return c.get(decimals + guardDigits).Round(decimals).SetDecimals(decimals)
+}
+
+var piConstant = &constant{
+	compute: func(decimals int) *BigFloat { // Machin's formula: pi = 16 * atan(1/5) - 4 * atan(1/239)
+		wp := decimals + guardDigits
+		a := atanSeries(divWP(SetInt64(1), SetInt64(5), wp), wp).MulInt64(16)
+		b := atanSeries(divWP(SetInt64(1), SetInt64(239), wp), wp).MulInt64(4)
+
+		return New().Sub(a, b)
+	},
+	refine: func(x *BigFloat, decimals int) *BigFloat { // x + sin(x)
+		wp := decimals + guardDigits
+
+		return New().Add(x, sinSeries(x, wp)).roundTo(wp)
+	},
+}
+
+var eConstant = &constant{
+	compute: func(decimals int) *BigFloat {
+		e, _ := exp(SetInt64(1), decimals)
+
+		return e
+	},
+	refine: func(x *BigFloat, decimals int) *BigFloat { // x * (2 - ln(x))
+		wp := decimals + guardDigits
+
+		return mulWP(x, New().Sub(SetInt64(2), ln(x, wp)), wp)
+	},
+}
+
+var ln2Constant = &constant{
+	compute: func(decimals int) *BigFloat { // ln(2) = 2 * atanh(1/3)
+		wp := decimals + guardDigits
+		z := divWP(SetInt64(1), SetInt64(3), wp)
+
+		return atanhSeries(z, wp).MulInt64(2)
+	},
+	refine: func(y *BigFloat, decimals int) *BigFloat { // y - 1 + 2 * e^-y
+		wp := decimals + guardDigits
+		e, _ := exp(y.Copy().Neg(), wp)
+
+		return New().Add(New().Sub(y, SetInt64(1)), e.MulInt64(2)).roundTo(wp)
+	},
+}
+
+var ln10Constant = &constant{
+	compute: func(decimals int) *BigFloat { // ln(10) = 3 * ln(2) + 2 * atanh(1/9)
+		wp := decimals + guardDigits
+		z := divWP(SetInt64(1), SetInt64(9), wp)
+		r := atanhSeries(z, wp).MulInt64(2)
+
+		return New().Add(ln2(wp).MulInt64(3), r)
+	},
+	refine: func(y *BigFloat, decimals int) *BigFloat { // y - 1 + 10 * e^-y
+		wp := decimals + guardDigits
+		e, _ := exp(y.Copy().Neg(), wp)
+
+		return New().Add(New().Sub(y, SetInt64(1)), e.MulInt64(10)).roundTo(wp)
+	},
+}
+
+var sqrt2Constant = &constant{
+	compute: func(decimals int) *BigFloat {
+		s, _, _ := New().Sqrt(SetInt64(2), WithDecimalPlaces(decimals+guardDigits))
+
+		return s
+	},
+	refine: func(x *BigFloat, decimals int) *BigFloat { // (x + 2/x) / 2
+		wp := decimals + guardDigits
+		s := New().Add(x, divWP(SetInt64(2), x, wp))
+
+		return divWP(s, SetInt64(2), wp)
+	},
+}
+
+/*
+For internal use
+Calculates pi with absolute error less then 10^-decimals
+*/
+func pi(decimals int) *BigFloat {
+	return piConstant.get(decimals)
+}
+
+/*
+For internal use
+Calculates ln(2) with absolute error less then 10^-decimals
+*/
+func ln2(decimals int) *BigFloat {
+	return ln2Constant.get(decimals)
+}
+
+/*
+For internal use
+Calculates ln(10) with absolute error less then 10^-decimals
+*/
+func ln10(decimals int) *BigFloat {
+	return ln10Constant.get(decimals)
+}
+
+/*
+Returns pi rounded to decimals
+
+Value is cached and safe for concurrent use.
+*/
+func Pi(decimals int) *BigFloat {
+	return piConstant.rounded(decimals)
+}
+
+/*
+Returns Euler's number e rounded to decimals
+
+Value is cached and safe for concurrent use.
+*/
+func E(decimals int) *BigFloat {
+	return eConstant.rounded(decimals)
+}
+
+/*
+Returns natural logarithm of 2 rounded to decimals
+
+Value is cached and safe for concurrent use.
+*/
+func Ln2(decimals int) *BigFloat {
+	return ln2Constant.rounded(decimals)
+}
+
+/*
+Returns natural logarithm of 10 rounded to decimals
+
+Value is cached and safe for concurrent use.
+*/
+func Ln10(decimals int) *BigFloat {
+	return ln10Constant.rounded(decimals)
+}
+
+/*
+Returns square root of 2 rounded to decimals
+
+Value is cached and safe for concurrent use.
+*/
+func Sqrt2(decimals int) *BigFloat {
+	return sqrt2Constant.rounded(decimals)
+}
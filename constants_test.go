@@ -0,0 +1,122 @@
+package bigfloat
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+var constantDigits = map[string]string{
+	"pi":    "3.1415926535897932384626433832795028841971693993751058209749445923078164062862089986280348253421170680",
+	"e":     "2.7182818284590452353602874713526624977572470936999595749669676277240766303535475945713821785251664274",
+	"ln2":   "0.6931471805599453094172321214581765680755001343602552541206800094933936219696947156058633269964186875",
+	"ln10":  "2.3025850929940456840179914546843642076011014886287729760333279009675726096773524802359972050895982983",
+	"sqrt2": "1.4142135623730950488016887242096980785696718753769480731766797379907324784621070388503875343276415727",
+}
+
+var constantFunctions = map[string]func(int) *BigFloat{
+	"pi":    Pi,
+	"e":     E,
+	"ln2":   Ln2,
+	"ln10":  Ln10,
+	"sqrt2": Sqrt2,
+}
+
+var constantCaches = map[string]*constant{
+	"pi":    piConstant,
+	"e":     eConstant,
+	"ln2":   ln2Constant,
+	"ln10":  ln10Constant,
+	"sqrt2": sqrt2Constant,
+}
+
+func TestConstants(t *testing.T) {
+	var cases = []struct {
+		name     string
+		decimals int
+		expected string
+	}{
+		{"pi", 0, "3"},
+		{"pi", 4, "3.1416"},
+		{"pi", 50, "3.14159265358979323846264338327950288419716939937511"},
+		{"pi", 10, "3.1415926536"},
+		{"e", 20, "2.71828182845904523536"},
+		{"e", 60, "2.718281828459045235360287471352662497757247093699959574966968"},
+		{"e", 3, "2.718"},
+		{"ln2", 15, "0.693147180559945"},
+		{"ln2", 70, "0.6931471805599453094172321214581765680755001343602552541206800094933936"},
+		{"ln10", 30, "2.302585092994045684017991454684"},
+		{"ln10", 80, "2.30258509299404568401799145468436420760110148862877297603332790096757260967735248"},
+		{"sqrt2", 1, "1.4"},
+		{"sqrt2", 40, "1.4142135623730950488016887242096980785697"},
+	}
+	fmt.Printf("\nTestConstants...\n")
+	for _, c := range cases {
+		fmt.Printf("%v(%v) = ", c.name, c.decimals)
+
+		expectedStr := c.expected
+		result := constantFunctions[c.name](c.decimals).String()
+
+		fmt.Printf("%v\n", result)
+		printResult(t, result, expectedStr, nil)
+	}
+}
+
+func TestConstantsRefine(t *testing.T) {
+	fmt.Printf("\nTestConstantsRefine...\n")
+	for name, cache := range constantCaches {
+		fmt.Printf("%v refined from 5 to 95 decimals = ", name)
+		c := &constant{ // empty cache with the same calculations
+			compute: cache.compute,
+			refine:  cache.refine,
+		}
+		c.get(5)
+		r := c.get(95)
+
+		expected, _ := SetString(constantDigits[name])
+		expectedStr := expected.Round(95).String()
+		result := r.Round(95).String()
+
+		fmt.Printf("%v\n", result)
+		printResult(t, result, expectedStr, nil)
+
+		if c.decimals != 160 { // 5 doubled 5 times
+			t.Errorf("cached decimals should be 160 (found %v)", c.decimals)
+		}
+
+		value := c.value
+		c.get(96) // slightly more decimals are served from cache
+		if c.value != value {
+			t.Errorf("cached value should not be refined for 96 decimals")
+		}
+	}
+}
+
+func TestConstantsConcurrent(t *testing.T) {
+	fmt.Printf("\nTestConstantsConcurrent...\n")
+	var wg sync.WaitGroup
+	results := make([]string, 20)
+	for i := 0; i < len(results); i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = Pi(30 + i%3*10).Round(30).String()
+		}(i)
+	}
+	wg.Wait()
+
+	for _, result := range results {
+		printResult(t, result, "3.141592653589793238462643383280", nil)
+	}
+}
+
+func TestConstantsNotShared(t *testing.T) {
+	fmt.Printf("\nTestConstantsNotShared...\n")
+	n := Pi(20)
+	n.Trunc(WithDecimalPlaces(0))
+	n.SetDecimals(20)
+
+	result := Pi(20).String()
+	fmt.Printf("%v\n", result)
+	printResult(t, result, "3.14159265358979323846", nil)
+}
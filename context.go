@@ -0,0 +1,382 @@
+/*
+Copyright 2023 Tihomir Magdic. All rights reserved.
+Use of this source code is governed by a BSD-style
+license that can be found in the LICENSE file.
+*/
+
+package bigfloat
+
+import (
+	"fmt"
+	"stranalyzer"
+	"sync"
+)
+
+/*
+Arithmetic context defines numeric policy for a whole calculation:
+
+	precision - number of significant digits of result (default is 0 - not used)
+	decimalPlaces - fixed number of decimal places of result (default is -1 - not used)
+	roundingMode - rounding of result (default is RoundHalfUp)
+	maxDigits - maximum number of digits of result (default is 0 - unlimited)
+	maxDecimalPlaces - safety parameter for exact division (default is 1e4 - 10000)
+	traps - conditions which are returned as error (default is 0 - none)
+
+Without precision and decimal places results are exact, so division with repeating decimals returns error.
+Conditions raised by operations are accumulated in context (see Flags). Context is safe for concurrent use.
+*/
+type Context struct {
+	precision        int
+	decimalPlaces    int
+	roundingMode     RoundingMode
+	maxDigits        int
+	maxDecimalPlaces int
+	traps            Condition
+
+	mu    sync.Mutex
+	flags Condition // accumulated conditions
+}
+
+/*
+Function type for context option.
+
+See: NewContext
+*/
+type ContextOption func(*Context)
+
+/*
+Function defines number of significant digits of results
+*/
+func WithPrecision(precision int) ContextOption {
+	return func(c *Context) {
+		c.precision = precision
+	}
+}
+
+/*
+Function defines fixed number of decimal places of results
+*/
+func WithScale(decimalPlaces int) ContextOption {
+	return func(c *Context) {
+		c.decimalPlaces = decimalPlaces
+	}
+}
+
+/*
+Function defines rounding mode of results
+*/
+func WithContextRoundingMode(mode RoundingMode) ContextOption {
+	return func(c *Context) {
+		c.roundingMode = mode
+	}
+}
+
+/*
+Function defines maximum number of digits (whole number and decimals) of results
+Operation returns error when limit is exceeded
+*/
+func WithContextMaxDigits(maxDigits int) ContextOption {
+	return func(c *Context) {
+		c.maxDigits = maxDigits
+	}
+}
+
+/*
+Function defines maximum decimals in exact division
+Effective with very long decimals
+*/
+func WithContextMaxDecimalPlaces(maxDecimalPlaces int) ContextOption {
+	return func(c *Context) {
+		c.maxDecimalPlaces = maxDecimalPlaces
+	}
+}
+
+/*
+Function defines conditions which are returned as error (e.g. Inexact to refuse any silent rounding)
+Result of trapped operation is not set, but condition is accumulated in flags
+*/
+func WithTraps(traps Condition) ContextOption {
+	return func(c *Context) {
+		c.traps = traps
+	}
+}
+
+/*
+Creates new arithmetic context with ContextOption (see Context)
+Returns error for invalid options or if both precision and decimal places are defined
+*/
+func NewContext(options ...ContextOption) (*Context, error) {
+	c := &Context{ // default option values
+		precision:        0,
+		decimalPlaces:    -1,
+		roundingMode:     RoundHalfUp,
+		maxDigits:        0,
+		maxDecimalPlaces: int(1e4),
+	}
+	for _, option := range options {
+		option(c)
+	}
+
+	if c.precision < 0 {
+		return nil, fmt.Errorf("ERROR: Negative precision. Precision should be 0 or positive")
+	} else if c.decimalPlaces < -1 {
+		return nil, fmt.Errorf("ERROR: Negative decimal places. Decimal places should be 0 or positive")
+	} else if c.precision > 0 && c.decimalPlaces >= 0 {
+		return nil, fmt.Errorf("ERROR: Context can have either precision or decimal places")
+	} else if !c.roundingMode.valid() {
+		return nil, fmt.Errorf("ERROR: Invalid rounding mode")
+	} else if c.maxDigits < 0 || c.maxDecimalPlaces < 0 {
+		return nil, fmt.Errorf("ERROR: Negative digit limit")
+	}
+
+	return c, nil
+}
+
+/*
+Returns number of significant digits of results (0 if not used)
+*/
+func (c *Context) Precision() int {
+	return c.precision
+}
+
+/*
+Returns fixed number of decimal places of results (-1 if not used)
+*/
+func (c *Context) DecimalPlaces() int {
+	return c.decimalPlaces
+}
+
+/*
+Returns rounding mode of results
+*/
+func (c *Context) RoundingMode() RoundingMode {
+	return c.roundingMode
+}
+
+/*
+Returns conditions accumulated in context since creation or last ClearFlags
+*/
+func (c *Context) Flags() Condition {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.flags
+}
+
+/*
+Clears accumulated conditions
+*/
+func (c *Context) ClearFlags() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.flags = 0
+}
+
+/*
+For internal use
+Accumulates conditions in context flags and returns error for trapped conditions
+*/
+func (c *Context) raise(cond Condition) error {
+	c.mu.Lock()
+	c.flags |= cond
+	c.mu.Unlock()
+
+	if trapped := cond & c.traps; trapped != 0 {
+		return fmt.Errorf("ERROR: Trapped condition (%v)", trapped)
+	}
+
+	return nil
+}
+
+/*
+For internal use
+Returns InvalidOperation if result is NaN and none of operands is NaN
+*/
+func invalidOperation(r *BigFloat, args ...*BigFloat) Condition {
+	if !r.IsNaN() {
+		return 0
+	}
+	for _, a := range args {
+		if a.IsNaN() {
+			return 0
+		}
+	}
+
+	return InvalidOperation
+}
+
+/*
+For internal use
+Rounds result with context precision or decimal places and checks digit limit
+Argument sticky tells if there are non zero digits after the last digit of result (see round)
+Argument cond contains conditions already raised by operation
+Negative result rounded to zero is negative zero (e.g. -0.4 rounded to 0 decimals is -0)
+*/
+func (c *Context) apply(z, r *BigFloat, sticky bool, cond Condition) (*BigFloat, error) {
+	nonZero := !r.IsInt64(0) || sticky
+	negative := r.Signbit()
+
+	if c.precision > 0 {
+		cond |= r.roundSig(c.precision, c.roundingMode, sticky)
+	} else if c.decimalPlaces >= 0 {
+		cond |= r.roundCond(c.decimalPlaces, c.roundingMode, sticky)
+		r.SetDecimals(c.decimalPlaces)
+	}
+
+	if (nonZero || cond&Inexact != 0) && r.IsInt64(0) { // non zero result rounded to zero
+		cond |= Underflow
+	}
+	if negative && r.IsFinite() && r.IsInt64(0) { // rounding keeps sign of zero
+		r.analysis.Special = stranalyzer.NegZero
+	}
+
+	overflow := c.maxDigits > 0 && r.digits() > c.maxDigits
+	if overflow {
+		cond |= Overflow
+	}
+
+	if err := c.raise(cond); err != nil {
+		return nil, err
+	} else if overflow {
+		return nil, fmt.Errorf("ERROR: Result exceeds maximum number of digits (%d)", c.maxDigits)
+	}
+
+	z.analysis = r.analysis
+
+	return z, nil
+}
+
+/*
+Sets z to x rounded with context
+*/
+func (c *Context) Round(z, x *BigFloat) (*BigFloat, error) {
+	return c.apply(z, x.clone(), false, 0)
+}
+
+/*
+For internal use
+Sets sign of exact zero sum r of operands with opposite signs, which is negative only with RoundFloor (e.g. 1 - 1 = -0)
+*/
+func (c *Context) zeroSum(r *BigFloat, opposite bool) *BigFloat {
+	if opposite && c.roundingMode == RoundFloor && r.IsInt64(0) {
+		r.analysis.Special = stranalyzer.NegZero
+	}
+
+	return r
+}
+
+/*
+Sets z to x + y rounded with context
+*/
+func (c *Context) Add(z, x, y *BigFloat) (*BigFloat, error) {
+	r := c.zeroSum(New().Add(x, y), x.Signbit() != y.Signbit())
+
+	return c.apply(z, r, false, invalidOperation(r, x, y))
+}
+
+/*
+Sets z to x - y rounded with context
+*/
+func (c *Context) Sub(z, x, y *BigFloat) (*BigFloat, error) {
+	r := c.zeroSum(New().Sub(x, y), x.Signbit() == y.Signbit())
+
+	return c.apply(z, r, false, invalidOperation(r, x, y))
+}
+
+/*
+For internal use
+Pads exact result with trailing zeroes up to ideal decimal places (e.g. 1.20 * 3 = 3.60, 2.40 / 2 = 1.20)
+In context with precision result is padded only up to precision significant digits
+*/
+func (c *Context) ideal(r *BigFloat, decimals int) *BigFloat {
+	if !r.IsFinite() || r.isCompact() {
+		return r
+	}
+
+	if c.precision > 0 && !r.IsInt64(0) && decimals > c.precision-r.exponent() {
+		decimals = c.precision - r.exponent()
+	}
+	if decimals > r.analysis.Decimals {
+		r.SetDecimals(decimals)
+	}
+
+	return r
+}
+
+/*
+Sets z to x * y rounded with context
+Exact product has sum of decimal places of operands (see Mul)
+*/
+func (c *Context) Mul(z, x, y *BigFloat) (*BigFloat, error) {
+	r := c.ideal(New().Mul(x, y), x.decimals()+y.decimals())
+
+	return c.apply(z, r, false, invalidOperation(r, x, y))
+}
+
+/*
+Sets z to x / y rounded with context
+Exact quotient has difference of decimal places of operands if it is not shorter (e.g. 2.40 / 2 = 1.20, 1 / 8 = 0.125)
+Returns error for division by zero, and for repeating decimals in context without precision and decimal places
+*/
+func (c *Context) Div(z, x, y *BigFloat) (*BigFloat, error) {
+	if r, ok := divSpecial(x, y, false); ok { // NaN, infinity or negative zero
+		return c.apply(z, r, false, invalidOperation(r, x, y))
+	}
+
+	if y.IsInt64(0) {
+		cond := DivisionByZero
+		if x.IsInt64(0) { // 0 / 0
+			cond = InvalidOperation
+		}
+		if err := c.raise(cond); err != nil {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("ERROR: Division by zero")
+	}
+
+	if x.IsInt64(0) {
+		return c.apply(z, New(), false, 0)
+	}
+
+	if c.decimalPlaces >= 0 { // division rounds last decimal
+		q := New()
+		var cond Condition
+		_, _, err := q.Div(x, y, WithDivDecimalPlaces(c.decimalPlaces), WithDivRoundingMode(c.roundingMode), WithDivMaxDecimalPlaces(maxInt(c.maxDecimalPlaces, c.decimalPlaces+1)), WithDivConditions(&cond))
+		if err != nil {
+			return nil, err
+		}
+		if x.Signbit() != y.Signbit() && q.IsInt64(0) { // negative quotient rounded to zero
+			q.analysis.Special = stranalyzer.NegZero
+		}
+
+		return c.apply(z, q, false, cond)
+	}
+
+	if c.precision > 0 { // quotient has exponent x.exponent() - y.exponent() or one more
+		decimals := maxInt(c.precision-x.exponent()+y.exponent()+1, 0)
+		q := New()
+		_, _, err := q.Div(x, y, WithDivDecimalPlaces(decimals), WithDivRoundingMode(RoundDown), WithDivMaxDecimalPlaces(maxInt(c.maxDecimalPlaces, decimals+1)))
+		if err != nil {
+			return nil, err
+		}
+		sticky := !New().Sub(x, New().Mul(q, y)).IsInt64(0) // truncated quotient is not exact
+		if !sticky {
+			c.ideal(q.trimDecimals(), x.decimals()-y.decimals()) // exact quotient without extra zeroes
+		}
+
+		return c.apply(z, q, sticky, 0)
+	}
+
+	q := New() // exact division
+	_, repDec, err := q.Div(x, y, WithDivMaxDecimalPlaces(c.maxDecimalPlaces))
+	if err != nil {
+		return nil, err
+	}
+	if repDec > 0 || q.analysis.Decimals >= c.maxDecimalPlaces {
+		return nil, fmt.Errorf("ERROR: Division is not exact. Precision or decimal places are required")
+	}
+
+	return c.apply(z, c.ideal(q, x.decimals()-y.decimals()), false, 0)
+}
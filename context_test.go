@@ -0,0 +1,208 @@
+package bigfloat
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestContextPrecision(t *testing.T) {
+	var cases = []struct {
+		op        string
+		param1    string
+		param2    string
+		precision int
+		mode      RoundingMode
+		expected  string
+	}{
+		{"div", "2", "3", 5, RoundHalfUp, "0.66667"},
+		{"div", "1", "7", 5, RoundHalfUp, "0.14286"},
+		{"div", "123456789", "0.001", 5, RoundHalfUp, "123460000000"},
+		{"div", "1", "800", 5, RoundHalfUp, "0.00125"},
+		{"div", "1", "800", 2, RoundHalfEven, "0.0012"},
+		{"div", "10", "8", 3, RoundHalfEven, "1.25"},
+		{"div", "-1", "3", 3, RoundFloor, "-0.334"},
+		{"div", "-100000", "3", 3, RoundFloor, "-33400"},
+		{"div", "1.25001", "1", 2, RoundHalfEven, "1.3"},
+		{"div", "2.40", "2", 5, RoundHalfUp, "1.20"},
+		{"div", "2.40000", "2", 5, RoundHalfUp, "1.2000"},
+		{"mul", "1.2345", "6.789", 5, RoundHalfUp, "8.3810"},
+		{"mul", "1.20", "3", 5, RoundHalfUp, "3.60"},
+		{"mul", "2.5", "4", 5, RoundHalfUp, "10.0"},
+		{"mul", "1.25", "4.00", 3, RoundHalfUp, "5.00"},
+		{"add", "99999", "0.5", 5, RoundHalfUp, "100000"},
+		{"sub", "1", "0.0000001", 3, RoundDown, "0.999"},
+		{"sub", "1", "0.0000001", 3, RoundHalfUp, "1.00"},
+		{"sub", "1", "1", 5, RoundHalfUp, "0"},
+		{"sub", "1", "1", 5, RoundFloor, "-0"},
+		{"add", "1.25", "-1.25", 5, RoundFloor, "-0.00"},
+		{"add", "0", "-0", 5, RoundFloor, "-0"},
+		{"add", "-0", "-0", 5, RoundHalfUp, "-0"},
+	}
+	fmt.Printf("\nTestContextPrecision...\n")
+	for _, c := range cases {
+		fmt.Printf("%v(%v, %v, %v, %v) = ", c.op, c.param1, c.param2, c.precision, c.mode)
+		n1, n2, err := create2BigFloats(t, c.param1, c.param2, WithParseNegZero(true))
+		if err != nil {
+			continue
+		}
+
+		ctx, err := NewContext(WithPrecision(c.precision), WithContextRoundingMode(c.mode))
+		if err != nil {
+			t.Errorf("%v", err)
+			continue
+		}
+
+		n3 := New()
+		switch c.op {
+		case "add":
+			_, err = ctx.Add(n3, n1, n2)
+		case "sub":
+			_, err = ctx.Sub(n3, n1, n2)
+		case "mul":
+			_, err = ctx.Mul(n3, n1, n2)
+		case "div":
+			_, err = ctx.Div(n3, n1, n2)
+		}
+
+		result := n3.StringWith(SignedZero(true))
+		fmt.Printf("%v\n", result)
+		printResult(t, result, c.expected, err)
+	}
+}
+
+func TestContextScale(t *testing.T) {
+	var cases = []struct {
+		op       string
+		param1   string
+		param2   string
+		decimals int
+		mode     RoundingMode
+		expected string
+	}{
+		{"div", "2", "3", 2, RoundHalfUp, "0.67"},
+		{"div", "-1", "8", 2, RoundHalfEven, "-0.12"},
+		{"div", "10", "4", 0, RoundHalfEven, "2"},
+		{"div", "10", "4", 3, RoundHalfEven, "2.500"},
+		{"mul", "19.99", "0.075", 2, RoundHalfUp, "1.50"},
+		{"mul", "-19.99", "0.075", 2, RoundCeiling, "-1.49"},
+		{"add", "0.1", "0.2", 4, RoundHalfUp, "0.3000"},
+		{"sub", "10.005", "0.001", 2, RoundHalfEven, "10.00"},
+		{"round", "2.345", "", 2, RoundHalfEven, "2.34"},
+		{"round", "-0.4", "", 0, RoundHalfUp, "-0"},
+		{"div", "-1", "1000", 2, RoundHalfUp, "-0.00"},
+		{"div", "1", "-1000", 2, RoundCeiling, "-0.00"},
+		{"mul", "-0.001", "0.1", 2, RoundHalfUp, "-0.00"},
+	}
+	fmt.Printf("\nTestContextScale...\n")
+	for _, c := range cases {
+		fmt.Printf("%v(%v, %v, %v, %v) = ", c.op, c.param1, c.param2, c.decimals, c.mode)
+		n1, err := createBigFloat(t, c.param1)
+		if err != nil {
+			continue
+		}
+		n2 := New()
+		if c.param2 != "" {
+			if n2, err = createBigFloat(t, c.param2); err != nil {
+				continue
+			}
+		}
+
+		ctx, err := NewContext(WithScale(c.decimals), WithContextRoundingMode(c.mode))
+		if err != nil {
+			t.Errorf("%v", err)
+			continue
+		}
+
+		n3 := New()
+		switch c.op {
+		case "add":
+			_, err = ctx.Add(n3, n1, n2)
+		case "sub":
+			_, err = ctx.Sub(n3, n1, n2)
+		case "mul":
+			_, err = ctx.Mul(n3, n1, n2)
+		case "div":
+			_, err = ctx.Div(n3, n1, n2)
+		case "round":
+			_, err = ctx.Round(n3, n1)
+		}
+
+		result := n3.StringWith(SignedZero(true))
+		fmt.Printf("%v\n", result)
+		printResult(t, result, c.expected, err)
+	}
+}
+
+func TestContextExact(t *testing.T) {
+	fmt.Printf("\nTestContextExact...\n")
+	ctx, err := NewContext()
+	if err != nil {
+		t.Errorf("%v", err)
+		return
+	}
+
+	n1, n2, err := create2BigFloats(t, "1", "8")
+	if err != nil {
+		return
+	}
+	n3 := New()
+	_, err = ctx.Div(n3, n1, n2)
+
+	result := n3.String()
+	fmt.Printf("div(1, 8) = %v\n", result)
+	printResult(t, result, "0.125", err)
+
+	n4 := New()
+	_, err = ctx.Mul(n4, n3, n3)
+
+	result = n4.String()
+	fmt.Printf("mul(0.125, 0.125) = %v\n", result)
+	printResult(t, result, "0.015625", err)
+}
+
+func TestErrorsContext(t *testing.T) {
+	var cases = []struct {
+		name    string
+		options []ContextOption
+		param1  string
+		param2  string
+	}{
+		{"negative precision", []ContextOption{WithPrecision(-1)}, "1", "2"},
+		{"negative decimal places", []ContextOption{WithScale(-2)}, "1", "2"},
+		{"precision and decimal places", []ContextOption{WithPrecision(5), WithScale(2)}, "1", "2"},
+		{"invalid rounding mode", []ContextOption{WithContextRoundingMode(RoundingMode(42))}, "1", "2"},
+		{"negative max digits", []ContextOption{WithContextMaxDigits(-1)}, "1", "2"},
+		{"division by zero", []ContextOption{WithPrecision(5)}, "1", "0"},
+		{"inexact division", []ContextOption{}, "1", "3"},
+		{"max digits", []ContextOption{WithScale(2), WithContextMaxDigits(4)}, "1000", "3"},
+	}
+
+	fmt.Printf("\nTestErrorsContext...\n")
+	for _, c := range cases {
+		fmt.Printf("%v = ", c.name)
+		n1, n2, err := create2BigFloats(t, c.param1, c.param2)
+		if err != nil {
+			continue
+		}
+		func() {
+			defer func() {
+				if err := recover(); err != nil {
+					fmt.Printf("\nOK: panic occurred: %v\n", err)
+				}
+			}()
+
+			ctx, err := NewContext(c.options...)
+			if err != nil {
+				panic(err)
+			}
+			_, err = ctx.Div(New(), n1, n2)
+			if err != nil {
+				panic(err)
+			}
+
+			errorStr := fmt.Sprintf("%v should raise panic", c.name)
+			fmt.Printf("\n" + errorStr + "\n")
+			t.Errorf(errorStr)
+		}()
+	}
+}
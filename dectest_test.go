@@ -0,0 +1,433 @@
+package bigfloat
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+/*
+Conformance runner for General Decimal Arithmetic .decTest files (see speleotrove.com/decimal)
+Default directory is testdata, other suites can be run with:
+
+	go test -run TestDecTest -args -dectest=/path/to/dectest
+
+Failed cases fail the test, unsupported cases are skipped and reported with reason.
+*/
+var decTestDir = flag.String("dectest", "testdata", "directory with .decTest files")
+
+const (
+	decTestPassed = iota
+	decTestFailed
+	decTestUnsupported
+)
+
+type decTestCase struct {
+	id         string
+	op         string
+	operands   []string
+	result     string
+	conditions []string
+	precision  int
+	rounding   string
+}
+
+type decTestSummary struct {
+	passed      int
+	failed      []string
+	unsupported map[string]int // number of skipped cases by reason
+}
+
+var decTestRoundingModes = map[string]RoundingMode{
+	"half_up":   RoundHalfUp,
+	"half_down": RoundHalfDown,
+	"half_even": RoundHalfEven,
+	"up":        RoundUp,
+	"down":      RoundDown,
+	"ceiling":   RoundCeiling,
+	"floor":     RoundFloor,
+}
+
+var decTestConditions = map[string]Condition{
+	"inexact":             Inexact,
+	"rounded":             Rounded,
+	"division_by_zero":    DivisionByZero,
+	"invalid_operation":   InvalidOperation,
+	"division_impossible": InvalidOperation,
+	"division_undefined":  InvalidOperation,
+}
+
+var decTestLimitConditions = map[string]bool{ // exponent limits are not supported
+	"clamped":   true,
+	"overflow":  true,
+	"underflow": true,
+	"subnormal": true,
+}
+
+/*
+Splits line of .decTest file into tokens
+Comment starts with "--", tokens can be quoted with ' or " (quote is doubled inside quoted token)
+*/
+func decTestTokens(line string) ([]string, error) {
+	tokens := make([]string, 0)
+	for i := 0; i < len(line); {
+		switch c := line[i]; {
+		case c == ' ' || c == '\t':
+			i++
+		case strings.HasPrefix(line[i:], "--"):
+			return tokens, nil
+		case c == '\'' || c == '"':
+			var b strings.Builder
+			i++
+			for {
+				if i >= len(line) {
+					return nil, fmt.Errorf("ERROR: Missing closing quote")
+				}
+				if line[i] == c {
+					if i+1 < len(line) && line[i+1] == c { // doubled quote
+						b.WriteByte(c)
+						i += 2
+						continue
+					}
+					i++
+					break
+				}
+				b.WriteByte(line[i])
+				i++
+			}
+			tokens = append(tokens, b.String())
+		default:
+			j := i
+			for j < len(line) && line[j] != ' ' && line[j] != '\t' {
+				j++
+			}
+			tokens = append(tokens, line[i:j])
+			i = j
+		}
+	}
+
+	return tokens, nil
+}
+
+/*
+Parses test case from tokens: id operation operands -> result conditions
+*/
+func decTestParseCase(tokens []string, precision int, rounding string) (decTestCase, error) {
+	arrow := -1
+	for i, token := range tokens {
+		if token == "->" {
+			arrow = i
+			break
+		}
+	}
+	if arrow < 2 || arrow == len(tokens)-1 {
+		return decTestCase{}, fmt.Errorf("ERROR: Invalid test case %v", tokens)
+	}
+
+	conditions := make([]string, 0)
+	for _, c := range tokens[arrow+2:] {
+		conditions = append(conditions, strings.ToLower(c))
+	}
+
+	return decTestCase{
+		id:         tokens[0],
+		op:         strings.ToLower(tokens[1]),
+		operands:   tokens[2:arrow],
+		result:     tokens[arrow+1],
+		conditions: conditions,
+		precision:  precision,
+		rounding:   rounding,
+	}, nil
+}
+
+/*
+Runs all test cases from .decTest file (and included files)
+*/
+func decTestRunFile(path string, summary *decTestSummary) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	precision := 9
+	rounding := "half_up"
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		tokens, err := decTestTokens(scanner.Text())
+		if err != nil {
+			return fmt.Errorf("%v:%d: %v", path, line, err)
+		}
+		if len(tokens) == 0 {
+			continue
+		}
+
+		if strings.HasSuffix(tokens[0], ":") { // directive
+			if len(tokens) != 2 {
+				return fmt.Errorf("%v:%d: ERROR: Invalid directive", path, line)
+			}
+			switch value := strings.ToLower(tokens[1]); strings.ToLower(tokens[0]) {
+			case "precision:":
+				if precision, err = strconv.Atoi(value); err != nil {
+					return fmt.Errorf("%v:%d: ERROR: Invalid precision", path, line)
+				}
+			case "rounding:":
+				rounding = value
+			case "dectest:":
+				if err := decTestRunFile(filepath.Join(filepath.Dir(path), value+".decTest"), summary); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		c, err := decTestParseCase(tokens, precision, rounding)
+		if err != nil {
+			return fmt.Errorf("%v:%d: %v", path, line, err)
+		}
+		switch outcome, message := decTestRunCase(c); outcome {
+		case decTestPassed:
+			summary.passed++
+		case decTestFailed:
+			summary.failed = append(summary.failed, message)
+		default:
+			summary.unsupported[message]++
+		}
+	}
+
+	return scanner.Err()
+}
+
+/*
+Returns exponent of last digit of number in .decTest format (e.g. 2 for 1E+2, -1 for 1.5)
+*/
+func decTestExponent(s string) int {
+	mantissa, exponent := strings.ToLower(s), 0
+	if i := strings.IndexByte(mantissa, 'e'); i >= 0 {
+		exponent, _ = strconv.Atoi(mantissa[i+1:])
+		mantissa = mantissa[:i]
+	}
+	if i := strings.IndexByte(mantissa, '.'); i >= 0 {
+		exponent -= len(mantissa) - i - 1
+	}
+
+	return exponent
+}
+
+/*
+Returns canonical form of finite number in .decTest format as sign, coefficient and exponent (e.g. -15E-1 for -1.50E+0)
+Exponent of integer is not kept (e.g. 1E+2 and 100 are both 1E2), because decimal places of BigFloat number are not negative
+*/
+func decTestCanonical(s string) string {
+	mantissa, sign := strings.ToLower(s), ""
+	if mantissa[0] == '-' || mantissa[0] == '+' {
+		if mantissa[0] == '-' {
+			sign = "-"
+		}
+		mantissa = mantissa[1:]
+	}
+	exponent := decTestExponent(mantissa)
+	if i := strings.IndexByte(mantissa, 'e'); i >= 0 {
+		mantissa = mantissa[:i]
+	}
+
+	coefficient := strings.TrimLeft(strings.Replace(mantissa, ".", "", 1), "0")
+	if coefficient == "" {
+		coefficient = "0"
+	}
+	if exponent >= 0 { // integer
+		trimmed := strings.TrimRight(coefficient, "0")
+		exponent += len(coefficient) - len(trimmed)
+		coefficient = trimmed
+		if coefficient == "" { // zero
+			coefficient, exponent = "0", 0
+		}
+	}
+
+	return fmt.Sprintf("%v%vE%d", sign, coefficient, exponent)
+}
+
+/*
+Runs test case and returns outcome (with message for failed case and reason for unsupported case)
+*/
+func decTestRunCase(c decTestCase) (int, string) {
+	mode, ok := decTestRoundingModes[c.rounding]
+	if !ok {
+		return decTestUnsupported, fmt.Sprintf("rounding mode %v", c.rounding)
+	}
+
+	var expectedCond Condition
+	for _, name := range c.conditions {
+		if decTestLimitConditions[name] {
+			return decTestUnsupported, "exponent limits"
+		}
+		expectedCond |= decTestConditions[name]
+	}
+
+	args := make([]*BigFloat, len(c.operands))
+	for i, operand := range c.operands {
+		a, err := SetString(operand, WithParseNegZero(true))
+		if err != nil { // null operand (#), sNaN, or number out of range
+			return decTestUnsupported, "operand is not a BigFloat number"
+		}
+		args[i] = a
+	}
+
+	switch c.op {
+	case "add", "subtract", "multiply", "divide", "plus":
+		for i, operand := range c.operands {
+			if args[i].IsFinite() && decTestExponent(operand) > 0 { // e.g. 1E+12 has 1 digit, but 1000000000000 is rounded
+				return decTestUnsupported, "exponent of integer operand is not kept"
+			}
+		}
+	}
+
+	expected, err := SetString(c.result, WithParseNegZero(true))
+	if err != nil {
+		return decTestUnsupported, "result is not a BigFloat number"
+	}
+
+	ctx, err := NewContext(WithPrecision(c.precision), WithContextRoundingMode(mode))
+	if err != nil {
+		return decTestUnsupported, "precision"
+	}
+
+	var result *BigFloat
+	var cond Condition
+	checkCond := true
+	switch {
+	case c.op == "add" && len(args) == 2:
+		result, err = ctx.Add(New(), args[0], args[1])
+	case c.op == "subtract" && len(args) == 2:
+		result, err = ctx.Sub(New(), args[0], args[1])
+	case c.op == "multiply" && len(args) == 2:
+		result, err = ctx.Mul(New(), args[0], args[1])
+	case c.op == "divide" && len(args) == 2:
+		result, err = ctx.Div(New(), args[0], args[1])
+	case c.op == "plus" && len(args) == 1: // 0 + x
+		result, err = ctx.Add(New(), New(), args[0])
+	case c.op == "compare" && len(args) == 2:
+		if args[0].IsNaN() || args[1].IsNaN() { // NaN is ordered in Compare
+			return decTestUnsupported, "comparison with NaN"
+		}
+		result = SetInt64(int64(args[0].Compare(args[1])))
+		checkCond = false
+	case c.op == "quantize" && len(args) == 2:
+		if decTestExponent(c.operands[1]) > 0 { // decimal places of BigFloat number are not negative
+			return decTestUnsupported, "quantize to positive exponent"
+		}
+		maxIntDigits := 0 // coefficient of result is limited with precision
+		if args[1].IsFinite() {
+			maxIntDigits = maxInt(c.precision-args[1].decimals(), 0)
+		}
+		result, err = New().Quantize(args[0], args[1], mode, WithQuantizeMaxIntDigits(maxIntDigits))
+		if err != nil {
+			cond = InvalidOperation
+		}
+		checkCond = false
+	case (c.op == "tointegral" || c.op == "tointegralx") && len(args) == 1:
+		scaled, _ := NewContext(WithScale(0), WithContextRoundingMode(mode)) // negative number rounded to zero is -0
+		result, err = scaled.Round(New(), args[0])
+		cond = scaled.Flags() &^ Underflow // Underflow is raised only for exponent limits
+		checkCond = c.op == "tointegralx"
+	default:
+		return decTestUnsupported, fmt.Sprintf("operation %v", c.op)
+	}
+	if ctx.Flags() != 0 {
+		cond = ctx.Flags()
+	}
+
+	var passed bool
+	switch {
+	case err != nil || result == nil: // signaled condition instead of NaN or infinity
+		passed = (expected.IsNaN() || expected.IsInf(0)) && cond == expectedCond
+		checkCond = false
+	case expected.IsNaN():
+		passed = result.IsNaN()
+	case expected.IsInf(0):
+		passed = result.IsInf(expected.GetSign())
+	default: // coefficient and exponent are compared (e.g. 1.0 is not 1)
+		passed = result.IsFinite() && decTestCanonical(result.StringWith(SignedZero(true))) == decTestCanonical(c.result)
+	}
+	if checkCond && cond != expectedCond {
+		passed = false
+	}
+
+	if passed {
+		return decTestPassed, ""
+	}
+
+	got := fmt.Sprintf("%v", err)
+	if result != nil {
+		got = result.StringWith(SignedZero(true))
+	}
+
+	return decTestFailed, fmt.Sprintf("%v %v %v -> %v %v, got %.40s %v", c.id, c.op, strings.Join(c.operands, " "), c.result, expectedCond, got, cond)
+}
+
+func TestDecTestTokens(t *testing.T) {
+	var cases = []struct {
+		line     string
+		expected string
+	}{
+		{"addx001 add 1 1 -> 2", "[addx001 add 1 1 -> 2]"},
+		{"divx007 divide 1 3 -> 0.333333333 Inexact Rounded -- comment", "[divx007 divide 1 3 -> 0.333333333 Inexact Rounded]"},
+		{"precision:   9", "[precision: 9]"},
+		{"-- comment only", "[]"},
+		{"quax001 quantize '1 2' \"it\"\"s\" -> NaN", "[quax001 quantize 1 2 it\"s -> NaN]"},
+		{"\tcomx001  compare  -2  '-2'  ->  0", "[comx001 compare -2 -2 -> 0]"},
+	}
+	fmt.Printf("\nTestDecTestTokens...\n")
+	for _, c := range cases {
+		fmt.Printf("%q = ", c.line)
+		tokens, err := decTestTokens(c.line)
+
+		result := fmt.Sprintf("%v", tokens)
+		fmt.Printf("%v\n", result)
+		printResult(t, result, c.expected, err)
+	}
+
+	if _, err := decTestTokens("addx001 add '1 1 -> 2"); err == nil {
+		t.Errorf("missing closing quote should return error")
+	}
+}
+
+func TestDecTest(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join(*decTestDir, "*.decTest"))
+	if err != nil || len(files) == 0 {
+		t.Errorf("no .decTest files in %v", *decTestDir)
+		return
+	}
+
+	fmt.Printf("\nTestDecTest...\n")
+	for _, file := range files {
+		summary := decTestSummary{unsupported: make(map[string]int)}
+		if err := decTestRunFile(file, &summary); err != nil {
+			t.Errorf("%v", err)
+			continue
+		}
+
+		unsupported := 0
+		for _, n := range summary.unsupported {
+			unsupported += n
+		}
+		fmt.Printf("%v: %d passed, %d failed, %d unsupported\n", filepath.Base(file), summary.passed, len(summary.failed), unsupported)
+		reasons := make([]string, 0, len(summary.unsupported))
+		for reason := range summary.unsupported {
+			reasons = append(reasons, reason)
+		}
+		sort.Strings(reasons)
+		for _, reason := range reasons {
+			fmt.Printf("  SKIPPED %d (%v)\n", summary.unsupported[reason], reason)
+		}
+		for _, message := range summary.failed {
+			t.Errorf("%v: %v", filepath.Base(file), message)
+		}
+	}
+}
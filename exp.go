@@ -0,0 +1,123 @@
+/*
+Copyright 2023 Tihomir Magdic. All rights reserved.
+Use of this source code is governed by a BSD-style
+license that can be found in the LICENSE file.
+*/
+
+package bigfloat
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+/*
+Maximum number of whole number digits of exponential function result
+*/
+const maxExpDigits = int64(1e6)
+
+/*
+For internal use
+Returns if e^x < 10^-(decimals+1) (for x < -(decimals+1) * ln(10))
+*/
+func expUnderflow(x *BigFloat, decimals int) bool {
+	if x.GetSign() >= 0 {
+		return false
+	}
+
+	n, err := x.int64Part()
+
+	return err != nil || -n > int64(decimals+1)*231/100
+}
+
+/*
+For internal use
+Calculates e^x with absolute error less then 10^-decimals (result is not rounded)
+
+Argument is reduced as x = r * 2^m (r < 1/256) so Taylor series for e^r converges fast,
+then result is squared m times. Negative argument is calculated as 1 / e^-x.
+*/
+func exp(x *BigFloat, decimals int) (*BigFloat, error) {
+	if x.IsInt64(0) {
+		return SetInt64(1), nil
+	}
+
+	if expUnderflow(x, decimals) { // e^x is never zero, so tiny positive number is returned
+		return SetInt64(1).Div10(decimals + 2), nil
+	}
+
+	if x.GetSign() < 0 { // e^x = 1 / e^-x
+		wp := decimals + guardDigits
+		e, err := exp(x.Copy().Abs(), wp)
+		if err != nil {
+			return nil, err
+		}
+
+		return divWP(SetInt64(1), e, wp), nil
+	}
+
+	n, err := x.int64Part()
+	if err != nil || n > maxExpDigits*10000/4343 {
+		return nil, fmt.Errorf("ERROR: Argument of exponential function is too large")
+	}
+
+	resultDigits := int(n*4343/10000) + 1 // whole number digits of result (n / ln(10))
+	m := bits.Len64(uint64(n)) + 8        // number of squarings
+	wp := decimals + resultDigits + (m*302)/1000 + 1 + guardDigits
+
+	r := divWP(x, SetInt64(1<<m), wp) // reduced argument
+
+	sum := SetInt64(1) // Taylor series: 1 + r + r^2/2! + r^3/3! + ...
+	term := SetInt64(1)
+	for k := int64(1); ; k++ {
+		term = divWP(mulWP(term, r, wp), SetInt64(k), wp)
+		if term.IsInt64(0) {
+			break
+		}
+		sum = New().Add(sum, term)
+	}
+
+	for i := 0; i < m; i++ { // e^x = (e^r)^(2^m)
+		sum = mulWP(sum, sum, wp)
+	}
+
+	return sum, nil
+}
+
+/*
+Calculates e^x rounded to target decimal places with RoundOption:
+
+	roundingMode - rounding mode of result (default is RoundHalfUp)
+
+Large and negative arguments are reduced (see exp), so series is always summed for small argument.
+*/
+func (f *BigFloat) Exp(x *BigFloat, decimals int, options ...RoundOption) (*BigFloat, error) {
+	if decimals < 0 {
+		return nil, fmt.Errorf("ERROR: Negative decimal places. Decimal places should be 0 or positive")
+	}
+
+	ro, err := mathOptions(options, x)
+	if err != nil {
+		return nil, err
+	}
+	ro.decimalPlaces = decimals // decimals argument has precedence over decimalPlaces option
+
+	if expUnderflow(x, decimals) { // positive result below 10^-(decimals+1) is rounded as inexact (RoundUp and RoundCeiling give 10^-decimals)
+		r := SetInt64(1).Div10(decimals + 2)
+		report(ro.conditions, r.roundCond(decimals, ro.roundingMode, true))
+		f.analysis = r.SetDecimals(decimals).analysis
+
+		return f, nil
+	}
+
+	r, err := roundResult(ro, func(wp int) (*BigFloat, error) {
+		return exp(x, wp)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	f.analysis = r.analysis
+
+	return f, nil
+}
@@ -0,0 +1,92 @@
+package bigfloat
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestExp(t *testing.T) {
+	var cases = []struct {
+		param    string
+		decimals int
+		expected string
+	}{
+		{"0", 5, "1.00000"},
+		{"1", 30, "2.718281828459045235360287471353"},
+		{"-1", 30, "0.367879441171442321595523770161"},
+		{"0.05", 20, "1.05127109637602403970"},
+		{"2.302585092994045684", 15, "10.000000000000000"},
+		{"10", 10, "22026.4657948067"},
+		{"-10", 20, "0.00004539992976248485"},
+		{"100", 5, "26881171418161354484126255515800135873611118.77374"},
+		{"-100", 50, "0.00000000000000000000000000000000000000000003720076"},
+		{"0.0000001", 20, "1.00000010000000500000"},
+		{"-0.5", 25, "0.6065306597126334236037995"},
+		{"-1000", 10, "0.0000000000"},
+		{"-23", 10, "0.0000000001"},
+		{"-24", 10, "0.0000000000"},
+		{"-1e1001", 10, "0.0000000000"},
+		{"1e-1001", 5, "1.00000"},
+		{"3.5", 0, "33"},
+	}
+	fmt.Printf("\nTestExp...\n")
+	for _, c := range cases {
+		fmt.Printf("exp(%v, %v) = ", c.param, c.decimals)
+		n1, err := createBigFloat(t, c.param)
+		if err != nil {
+			continue
+		}
+
+		n2 := New()
+		_, err = n2.Exp(n1, c.decimals)
+		if err != nil {
+			fmt.Printf("%v\n", err)
+			t.Errorf("Exp error %v", err)
+			continue
+		}
+
+		expectedStr := c.expected
+		result := n2.String()
+
+		fmt.Printf("%v\n", result)
+		printResult(t, result, expectedStr, err)
+	}
+}
+
+func TestErrorsExp(t *testing.T) {
+	var cases = []struct {
+		param    string
+		decimals int
+	}{
+		{"1", -1},
+		{"10000000", 2},
+		{"99999999999999999999999", 2},
+		{"1e1001", 2},
+	}
+
+	fmt.Printf("\nTestErrorsExp...\n")
+	for _, c := range cases {
+		fmt.Printf("exp(%v, %v) = ", c.param, c.decimals)
+		n1, err := createBigFloat(t, c.param)
+		if err != nil {
+			continue
+		}
+		func() {
+			defer func() {
+				if err := recover(); err != nil {
+					fmt.Printf("\nOK: panic occurred: %v\n", err)
+				}
+			}()
+
+			n2 := New()
+			_, err := n2.Exp(n1, c.decimals)
+			if err != nil {
+				panic(err)
+			}
+
+			errorStr := fmt.Sprintf("%v should raise panic", c)
+			fmt.Printf("\n" + errorStr + "\n")
+			t.Errorf(errorStr)
+		}()
+	}
+}
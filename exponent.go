@@ -0,0 +1,126 @@
+/*
+Copyright 2023 Tihomir Magdic. All rights reserved.
+Use of this source code is governed by a BSD-style
+license that can be found in the LICENSE file.
+*/
+
+package bigfloat
+
+import (
+	"bytes"
+	"stranalyzer"
+)
+
+/*
+For internal use
+Returns if BigFloat number is in compact form (coefficient and exponent, see stranalyzer.Analysis)
+Compact form is used for numbers which need more than stranalyzer.MaxPadding zeroes (e.g. 1e1000000 or 1e-1000000)
+*/
+func (f *BigFloat) isCompact() bool {
+	return f.analysis.Exponent != 0
+}
+
+/*
+For internal use
+Returns BigFloat number with all digits (new number for compact form, otherwise the same number)
+Used for operands of operations which work with digits
+*/
+func (f *BigFloat) expanded() *BigFloat {
+	if !f.isCompact() {
+		return f
+	}
+
+	return &BigFloat{stranalyzer.Expand(f.analysis)}
+}
+
+/*
+For internal use
+Expands compact form of BigFloat number to all digits
+*/
+func (f *BigFloat) expand() *BigFloat {
+	if f.isCompact() {
+		f.analysis = stranalyzer.Expand(f.analysis)
+	}
+
+	return f
+}
+
+/*
+For internal use
+Returns integer coefficient c (with sign) and exponent e of BigFloat number f = c * 10^e
+*/
+func (f *BigFloat) coefficient() (*BigFloat, int) {
+	norm := bytes.TrimLeft(f.analysis.Norm, "0")
+	if len(norm) == 0 {
+		norm = []byte{'0'}
+	}
+
+	c := &BigFloat{stranalyzer.Analysis{
+		Norm: norm,
+		Len:  len(norm),
+		Sign: f.analysis.Sign,
+	}}
+
+	return c, f.analysis.Exponent - f.analysis.Decimals
+}
+
+/*
+For internal use
+Returns integer coefficients ac and bc of BigFloat numbers aligned to smaller exponent e (a = ac * 10^e, b = bc * 10^e)
+Numbers with similar exponents are not expanded (e.g. 3e-2000 and 1e-2000). Otherwise coefficient with larger exponent
+gets all zeroes up to smaller exponent, which can't be avoided in exact addition (e.g. 1e1000000 + 1 has all digits)
+*/
+func alignedCoefficients(a, b *BigFloat) (*BigFloat, *BigFloat, int) {
+	ac, ae := a.coefficient()
+	bc, be := b.coefficient()
+	e := ae
+	if be < e {
+		e = be
+	}
+
+	ac.analysis = stranalyzer.Expand(stranalyzer.Shift(ac.analysis, ae-e))
+	bc.analysis = stranalyzer.Expand(stranalyzer.Shift(bc.analysis, be-e))
+
+	return ac, bc, e
+}
+
+/*
+For internal use
+Returns number of decimals of BigFloat number (with all digits)
+*/
+func (f *BigFloat) decimals() int {
+	return maxInt(f.analysis.Decimals-f.analysis.Exponent, 0)
+}
+
+/*
+For internal use
+Returns number of digits of BigFloat number (with all digits)
+*/
+func (f *BigFloat) digits() int {
+	if f.analysis.Exponent > 0 {
+		return f.analysis.Len + f.analysis.Exponent
+	}
+
+	decimals := f.decimals()
+
+	return maxInt(f.analysis.Len-f.analysis.Decimals, 1) + decimals
+}
+
+/*
+For internal use
+Compares absolute values of non zero BigFloat numbers without expanding compact form
+Numbers are compared by exponent and then by significant digits
+*/
+func compareMagnitude(a, b *BigFloat) int {
+	if ea, eb := a.exponent(), b.exponent(); ea != eb {
+		if ea < eb {
+			return -1
+		}
+		return 1
+	}
+
+	da := bytes.TrimRight(bytes.TrimLeft(a.analysis.Norm, "0"), "0") // significant digits
+	db := bytes.TrimRight(bytes.TrimLeft(b.analysis.Norm, "0"), "0")
+
+	return bytes.Compare(da, db) // shorter digits with the same prefix are smaller
+}
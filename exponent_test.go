@@ -0,0 +1,325 @@
+package bigfloat
+
+import (
+	"fmt"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func allocatedBytes(f func()) uint64 { // bytes allocated by f
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	f()
+	runtime.ReadMemStats(&after)
+
+	return after.TotalAlloc - before.TotalAlloc
+}
+
+func compactString(n *BigFloat) string { // compact form in scientific notation
+	if n.isCompact() {
+		return n.StringWith(Scientific(true))
+	}
+
+	return n.String()
+}
+
+func TestCompactString(t *testing.T) {
+	var cases = []struct {
+		param    string
+		expected string
+	}{
+		{"1e1000000", "1E+1000000"},
+		{"1e-1000000", "1E-1000000"},
+		{"-1.5e-2000", "-1.5E-2000"},
+		{"12345e2000", "1.2345E+2004"},
+		{"1200e2000", "1.2E+2003"},
+		{"1.5e1", "15"},
+		{"0.5e1", "5"},
+		{"1.50e1", "15.0"},
+		{"1.2e-1", "0.12"},
+	}
+	fmt.Printf("\nTestCompactString...\n")
+	for _, c := range cases {
+		fmt.Printf("%v = ", c.param)
+		n, err := createBigFloat(t, c.param)
+		if err != nil {
+			continue
+		}
+
+		result := compactString(n)
+		fmt.Printf("%v\n", result)
+		printResult(t, result, c.expected, err)
+
+		if n.analysis.Len > 10 {
+			t.Errorf("%v should be in compact form", c.param)
+		}
+	}
+}
+
+func TestCompactPlainString(t *testing.T) {
+	var cases = []struct {
+		param    string
+		expected string
+	}{
+		{"1e1001", "1" + strings.Repeat("0", 1001)},
+		{"-1.5e1001", "-15" + strings.Repeat("0", 1000)},
+		{"1e-1001", "0." + strings.Repeat("0", 1000) + "1"},
+		{"-2.5e-1002", "-0." + strings.Repeat("0", 1001) + "25"},
+	}
+	fmt.Printf("\nTestCompactPlainString...\n")
+	for _, c := range cases {
+		fmt.Printf("%v = ", c.param)
+		n, err := createBigFloat(t, c.param)
+		if err != nil {
+			continue
+		}
+
+		result := n.String()
+		fmt.Printf("%.20v... (%d characters)\n", result, len(result))
+		printResult(t, result, c.expected, err)
+	}
+
+	n := New()
+	n.SetString("1")
+	result := n.Mul10(1001).String()
+	fmt.Printf("mul10(1, 1001) = %.20v... (%d characters)\n", result, len(result))
+	printResult(t, result, "1"+strings.Repeat("0", 1001), nil)
+}
+
+func TestScientificString(t *testing.T) {
+	var cases = []struct {
+		param    string
+		expected string
+	}{
+		{"1200", "1.2E+3"},
+		{"0.0012", "1.2E-3"},
+		{"-0.50", "-5E-1"},
+		{"7", "7E+0"},
+		{"0.000", "0E+0"},
+		{"1e1000000", "1E+1000000"},
+		{"-1.25e-2000", "-1.25E-2000"},
+	}
+	fmt.Printf("\nTestScientificString...\n")
+	for _, c := range cases {
+		fmt.Printf("%v = ", c.param)
+		n, err := createBigFloat(t, c.param)
+		if err != nil {
+			continue
+		}
+
+		result := n.StringWith(Scientific(true))
+		fmt.Printf("%v\n", result)
+		printResult(t, result, c.expected, err)
+	}
+}
+
+func TestScientificRepeating(t *testing.T) {
+	var cases = []struct {
+		param1   string
+		param2   string
+		expected string
+	}{
+		{"1", "3", "3.(3)E-1"},
+		{"1", "7", "1.(428571)E-1"},
+		{"-1", "70", "-1.(428571)E-2"},
+		{"1", "11", "9.(09)E-2"},
+		{"10", "7", "1.(428571)E+0"},
+		{"8", "15", "5.(3)E-1"},
+		{"1000", "7", "1.42(857142)E+2"},
+	}
+	fmt.Printf("\nTestScientificRepeating...\n")
+	for _, c := range cases {
+		fmt.Printf("div(%v, %v) = ", c.param1, c.param2)
+		n1, n2, err := create2BigFloats(t, c.param1, c.param2)
+		if err != nil {
+			continue
+		}
+
+		n3 := New()
+		_, repDec, err := n3.Div(n1, n2)
+
+		result := n3.StringF(repDec, Scientific(true))
+		fmt.Printf("%v\n", result)
+		printResult(t, result, c.expected, err)
+	}
+}
+
+func TestCompactShift(t *testing.T) {
+	var cases = []struct {
+		param    string
+		op       string
+		n        int
+		expected string
+	}{
+		{"1", "div10", 1000000, "1E-1000000"},
+		{"1", "mul10", 1000000, "1E+1000000"},
+		{"1e-1000000", "mul10", 1000000, "1"},
+		{"1e1000000", "div10", 999999, "10"},
+		{"1.50", "div10", 1, "0.150"},
+		{"-2.5", "div10", 5000, "-2.5E-5000"},
+		{"1", "pow10", 2000, "1E+2000"},
+		{"1", "pow10", -2000, "1E-2000"},
+	}
+	fmt.Printf("\nTestCompactShift...\n")
+	for _, c := range cases {
+		fmt.Printf("%v(%v, %v) = ", c.op, c.param, c.n)
+		n, err := createBigFloat(t, c.param)
+		if err != nil {
+			continue
+		}
+
+		switch c.op {
+		case "mul10":
+			n.Mul10(c.n)
+		case "div10":
+			n.Div10(c.n)
+		case "pow10":
+			n.Pow10(c.n)
+		}
+
+		result := compactString(n)
+		fmt.Printf("%v\n", result)
+		printResult(t, result, c.expected, err)
+	}
+}
+
+func TestCompactMul(t *testing.T) {
+	var cases = []struct {
+		param1   string
+		param2   string
+		expected string
+	}{
+		{"1e1000000", "1e-1000000", "1"},
+		{"2e-1500", "5e-1500", "1E-2999"},
+		{"1.5e2000", "2", "3E+2000"},
+		{"-1.5e2000", "0.02", "-3E+1998"},
+		{"1e2000", "0", "0"},
+		{"1e2000", "-1", "-1E+2000"},
+		{"4e-1001", "2.5e1001", "10"},
+	}
+	fmt.Printf("\nTestCompactMul...\n")
+	for _, c := range cases {
+		fmt.Printf("%v * %v = ", c.param1, c.param2)
+		n1, n2, err := create2BigFloats(t, c.param1, c.param2)
+		if err != nil {
+			continue
+		}
+
+		n3 := New()
+		n3.Mul(n1, n2)
+		result := compactString(n3)
+
+		fmt.Printf("%v\n", result)
+		printResult(t, result, c.expected, err)
+	}
+}
+
+func TestCompactCompare(t *testing.T) {
+	var cases = []struct {
+		param1   string
+		param2   string
+		expected int
+	}{
+		{"1e1000000", "1e999999", 1},
+		{"-1e2000", "1", -1},
+		{"1e-2000", "0", 1},
+		{"-1e-2000", "0", -1},
+		{"1e-2000", "2e-2000", -1},
+		{"1.5e-2000", "1.50e-2000", 0},
+		{"1e2000", "123", 1},
+		{"-1e2000", "-1e2001", 1},
+		{"1.25e-2000", "1.2e-2000", 1},
+		{"1e1001", "1" + strings.Repeat("0", 1001), 0},
+	}
+	fmt.Printf("\nTestCompactCompare...\n")
+	for _, c := range cases {
+		fmt.Printf("compare(%.20v, %.20v) = ", c.param1, c.param2)
+		n1, n2, err := create2BigFloats(t, c.param1, c.param2)
+		if err != nil {
+			continue
+		}
+
+		result := n1.Compare(n2)
+		fmt.Printf("%v\n", result)
+		printResult(t, fmt.Sprint(result), fmt.Sprint(c.expected), err)
+	}
+}
+
+func TestCompactOperations(t *testing.T) {
+	var cases = []struct {
+		op       string
+		param1   string
+		param2   string
+		expected string
+	}{
+		{"add", "1e1001", "1", "1" + strings.Repeat("0", 1000) + "1"},
+		{"sub", "1e2000", "1e2000", "0"},
+		{"sub", "3e-2000", "1e-2000", "2E-2000"},
+		{"add", "-1e-2000", "-1e-2000", "-2E-2000"},
+		{"add", "1.5e-1000000", "2e-1000000", "3.5E-1000000"},
+		{"sub", "1e1000000", "3e999999", "7E+999999"},
+		{"sqrt", "1e1000000", "16", "1E+500000"},
+		{"sqrt", "4e-1000000", "500000", "2E-500000"},
+		{"sqrt", "4e-1000000", "16", "0.0000000000000000"},
+		{"cbrt", "-8e999999", "5", "-2E+333333"},
+		{"div", "1e2000", "1e1999", "10"},
+		{"div", "3e-2000", "3e-2000", "1"},
+		{"round", "1.25e-1500", "2", "0.00"},
+		{"roundSig", "1.25e-1500", "2", "1.3E-1500"},
+		{"roundSig", "9.96e1500", "2", "1E+1501"},
+		{"log10", "1e1000000", "", "1000000"},
+		{"ln", "1e1000000", "", "2302585.0929940456840180"},
+	}
+	fmt.Printf("\nTestCompactOperations...\n")
+	for _, c := range cases {
+		fmt.Printf("%v(%v, %v) = ", c.op, c.param1, c.param2)
+		n1, err := createBigFloat(t, c.param1)
+		if err != nil {
+			continue
+		}
+
+		n2 := New()
+		allocated := allocatedBytes(func() {
+			switch c.op {
+			case "add":
+				n2.SetString(c.param2)
+				n2.Add(n1, n2)
+			case "sub":
+				n2.SetString(c.param2)
+				n2.Sub(n1, n2)
+			case "div":
+				n2.SetString(c.param2)
+				_, _, err = n2.Div(n1, n2)
+			case "sqrt", "cbrt": // 2nd parameter is number of decimals
+				d, _ := strconv.Atoi(c.param2)
+				if c.op == "sqrt" {
+					_, _, err = n2.Sqrt(n1, WithDecimalPlaces(d))
+				} else {
+					_, _, err = n2.Cbrt(n1, d)
+				}
+			case "round":
+				n2 = n1.Round(2)
+			case "roundSig":
+				n2, _ = n1.RoundSig(2)
+			case "log10":
+				_, err = n2.Log10(n1)
+			case "ln":
+				_, err = n2.Ln(n1)
+			}
+		})
+
+		result := compactString(n2)
+		fmt.Printf("%.40v\n", result)
+		printResult(t, result, c.expected, err)
+
+		limit := uint64(1e6) // operands with similar exponents are not expanded to 1e6 digits
+		if c.op == "ln" {
+			limit = 1e7 // series of ln allocates a few MB for any argument
+		}
+		if allocated > limit {
+			t.Errorf("%v(%v, %v) allocated %v bytes", c.op, c.param1, c.param2, allocated)
+		}
+	}
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2023 Tihomir Magdic. All rights reserved.
+Use of this source code is governed by a BSD-style
+license that can be found in the LICENSE file.
+*/
+
+package bigfloat
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+/*
+Prime factor of integer BigFloat number with its multiplicity (e.g. 360 = 2^3 * 3^2 * 5)
+
+See: Factor
+*/
+type Factor struct {
+	Prime        *BigFloat
+	Multiplicity int
+}
+
+/*
+Limit of primes used in trial division
+*/
+const trialDivisionLimit = 10000
+
+var smallPrimesOnce sync.Once
+var smallPrimesList []uint32
+
+/*
+For internal use
+Returns primes below trialDivisionLimit (sieve of Eratosthenes, calculated once)
+*/
+func smallPrimes() []uint32 {
+	smallPrimesOnce.Do(func() {
+		composite := make([]bool, trialDivisionLimit)
+		for i := 2; i < trialDivisionLimit; i++ {
+			if composite[i] {
+				continue
+			}
+			smallPrimesList = append(smallPrimesList, uint32(i))
+			for j := i * i; j < trialDivisionLimit; j += i {
+				composite[j] = true
+			}
+		}
+	})
+
+	return smallPrimesList
+}
+
+/*
+Factorizes absolute value of integer BigFloat number into primes (sorted ascending, 1 has no factors)
+Small prime factors are found with trial division and others with Pollard's rho algorithm (Brent's variant).
+Duration of factorization depends on the second largest prime factor, so long factorization can be cancelled with context.
+Returns error for non integer numbers, for 0 and if context is cancelled (error wraps context error)
+*/
+func (f *BigFloat) Factor(ctx context.Context) ([]Factor, error) {
+	if err := checkInt(f); err != nil {
+		return nil, err
+	}
+
+	n := toNat(f)
+	if len(n) == 0 {
+		return nil, fmt.Errorf("ERROR: Factorization of zero")
+	}
+
+	primes := make([]nat, 0)
+	for _, p := range smallPrimes() { // trial division
+		if natCmp(nat{p * p}, n) > 0 {
+			break
+		}
+		for {
+			q, r := natDivWord(n, p)
+			if r != 0 {
+				break
+			}
+			n = q
+			primes = append(primes, nat{p})
+		}
+	}
+
+	composites := make([]nat, 0)
+	if !n.isOne() {
+		composites = append(composites, n)
+	}
+	for len(composites) > 0 { // split numbers until all are prime
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("ERROR: Factorization is cancelled (%w)", err)
+		}
+
+		m := composites[len(composites)-1]
+		composites = composites[:len(composites)-1]
+
+		if natProbablyPrime(m, 20, true) {
+			primes = append(primes, m)
+			continue
+		}
+
+		if root := natSqrt(m); natCmp(natMul(root, root), m) == 0 { // rho is slow for square of large prime
+			composites = append(composites, root, root)
+			continue
+		}
+
+		for c := uint32(1); ; c++ { // another polynomial if divisor is not found
+			d, err := natPollardRho(ctx, m, c)
+			if err != nil {
+				return nil, fmt.Errorf("ERROR: Factorization is cancelled (%w)", err)
+			}
+			if natCmp(d, m) != 0 {
+				q, _ := natDivMod(m, d)
+				composites = append(composites, d, q)
+				break
+			}
+		}
+	}
+
+	sort.Slice(primes, func(i, j int) bool {
+		return natCmp(primes[i], primes[j]) < 0
+	})
+
+	factors := make([]Factor, 0)
+	for i, p := range primes {
+		if i > 0 && natCmp(p, primes[i-1]) == 0 {
+			factors[len(factors)-1].Multiplicity++
+		} else {
+			factors = append(factors, Factor{Prime: p.bigFloat(), Multiplicity: 1})
+		}
+	}
+
+	return factors, nil
+}
+
+/*
+For internal use
+Greatest common divisor of nat numbers (Euclid's algorithm)
+*/
+func natGCD(a, b nat) nat {
+	for len(b) > 0 {
+		a, b = b, natMod(a, b)
+	}
+
+	return a
+}
+
+/*
+For internal use
+Absolute difference of nat numbers
+*/
+func natAbsDiff(x, y nat) nat {
+	if natCmp(x, y) >= 0 {
+		return natSub(x, y)
+	}
+
+	return natSub(y, x)
+}
+
+/*
+For internal use
+Finds divisor of odd composite number n with Pollard's rho algorithm (Brent's variant) and polynomial x^2 + c
+Returns n if divisor is not found with this polynomial, context is checked after every batch of steps
+*/
+func natPollardRho(ctx context.Context, n nat, c uint32) (nat, error) {
+	const batch = 100 // steps between gcd calculations
+
+	next := func(x nat) nat { // x^2 + c modulo n
+		return natMod(natAdd(natMul(x, x), nat{c}), n)
+	}
+
+	y, q, g := nat{2}, nat{1}, nat{1}
+	var x, ys nat
+	for r := 1; g.isOne(); r *= 2 { // x is fixed while y makes r steps (Brent's cycle detection)
+		x = y
+		for i := 0; i < r; i++ {
+			y = next(y)
+		}
+		for k := 0; k < r && g.isOne(); k += batch {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			ys = y
+			for i := 0; i < batch && i < r-k; i++ { // product of differences is checked at once
+				y = next(y)
+				q = natMod(natMul(q, natAbsDiff(x, y)), n)
+			}
+			g = natGCD(q, n)
+		}
+	}
+
+	if natCmp(g, n) == 0 { // product contains all factors, repeat last batch step by step
+		for {
+			ys = next(ys)
+			g = natGCD(natAbsDiff(x, ys), n)
+			if !g.isOne() {
+				break
+			}
+		}
+	}
+
+	return g, nil
+}
@@ -0,0 +1,92 @@
+package bigfloat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func factorsString(factors []Factor) string {
+	s := make([]string, 0, len(factors))
+	for _, factor := range factors {
+		if factor.Multiplicity > 1 {
+			s = append(s, fmt.Sprintf("%v^%d", factor.Prime, factor.Multiplicity))
+		} else {
+			s = append(s, factor.Prime.String())
+		}
+	}
+
+	return strings.Join(s, " * ")
+}
+
+func TestFactor(t *testing.T) {
+	var cases = []struct {
+		param    string
+		expected string
+	}{
+		{"1", ""},
+		{"2", "2"},
+		{"360", "2^3 * 3^2 * 5"},
+		{"-360", "2^3 * 3^2 * 5"},
+		{"360.00", "2^3 * 3^2 * 5"},
+		{"97", "97"},
+		{"1024", "2^10"},
+		{"99980001", "3^4 * 11^2 * 101^2"},
+		{"600851475143", "71 * 839 * 1471 * 6857"},
+		{"1000000016000000063", "1000000007 * 1000000009"},
+		{"1000000014000000049", "1000000007^2"},
+		{"18446744073709551617", "274177 * 67280421310721"},
+		{"100000000000000000001", "73 * 137 * 1676321 * 5964848081"},
+		{"11999999411999990003999962956", "2^2 * 3 * 999999937 * 1000000007^2"},
+		{"1020847100762815390390123822295304634362", "2 * 3 * 170141183460469231731687303715884105727"},
+		{"1E+30", "2^30 * 5^30"},
+	}
+	fmt.Printf("\nTestFactor...\n")
+	for _, c := range cases {
+		fmt.Printf("factor(%v) = ", c.param)
+		n, err := createBigFloat(t, c.param)
+		if err != nil {
+			continue
+		}
+
+		factors, err := n.Factor(context.Background())
+
+		result := factorsString(factors)
+		fmt.Printf("%v\n", result)
+		printResult(t, result, c.expected, err)
+	}
+}
+
+func TestFactorCancel(t *testing.T) {
+	fmt.Printf("\nTestFactorCancel...\n")
+	n, _ := SetString("1427247692705959880439315947500961989719490561") // (2^61 - 1) * (2^89 - 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := n.Factor(ctx)
+	fmt.Printf("factor(%v) = %v\n", n, err)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("factor(%v) should return context.Canceled", n)
+	}
+}
+
+func TestErrorsFactor(t *testing.T) {
+	var cases = []string{"0", "1.5", "0.01", "NaN", "-Inf"}
+	fmt.Printf("\nTestErrorsFactor...\n")
+	for _, c := range cases {
+		fmt.Printf("factor(%v) = ", c)
+		n, err := createBigFloat(t, c)
+		if err != nil {
+			continue
+		}
+
+		_, err = n.Factor(context.Background())
+		fmt.Printf("%v\n", err)
+		if err == nil {
+			t.Errorf("factor(%v) should return error", c)
+		}
+	}
+}
@@ -0,0 +1,289 @@
+/*
+Copyright 2023 Tihomir Magdic. All rights reserved.
+Use of this source code is governed by a BSD-style
+license that can be found in the LICENSE file.
+*/
+
+package bigfloat
+
+import (
+	"fmt"
+)
+
+/*
+For internal use
+Calculates sinh(x) = x + x^3/3! + x^5/5! + ... rounded to n decimals in every step
+Used for small arguments to avoid cancellation in (e^x - e^-x) / 2
+*/
+func sinhSeries(x *BigFloat, n int) *BigFloat {
+	x2 := mulWP(x, x, n)
+	term := x.Copy()
+	sum := x.Copy()
+	for k := int64(2); ; k += 2 {
+		term = divWP(mulWP(term, x2, n), SetInt64(k*(k+1)), n)
+		if term.IsInt64(0) {
+			break
+		}
+		sum = New().Add(sum, term)
+	}
+
+	return sum
+}
+
+/*
+For internal use
+Calculates sinh(x) and cosh(x) with absolute error less then 10^-decimals
+*/
+func sinhCosh(x *BigFloat, decimals int) (*BigFloat, *BigFloat, error) {
+	wp := decimals + guardDigits
+
+	if x.CompareAbs(SetInt64(1)) < 0 { // series for small argument, cosh(x) = sqrt(1 + sinh(x)^2)
+		s := sinhSeries(x, wp)
+		c, _, _ := New().Sqrt(New().Add(SetInt64(1), New().Mul(s, s)), WithDecimalPlaces(wp))
+
+		return s, c, nil
+	}
+
+	e1, err := exp(x, wp) // e^x
+	if err != nil {
+		return nil, nil, err
+	}
+	e2, err := exp(x.Copy().Neg(), wp) // e^-x
+	if err != nil {
+		return nil, nil, err
+	}
+
+	two := SetInt64(2)
+	s := divWP(New().Sub(e1, e2), two, wp)
+	c := divWP(New().Add(e1, e2), two, wp)
+
+	return s, c, nil
+}
+
+/*
+For internal use
+Returns decimal places of function result which is close to x near zero (sinh, tanh, asinh and atanh)
+For small argument |x| < 10^-(decimalPlaces/2) result has decimalPlaces significant digits, so it is not rounded to 0
+*/
+func smallArgumentDecimals(x *BigFloat, decimalPlaces int) int {
+	if x.IsInt64(0) || x.exponent() > -(decimalPlaces/2) {
+		return decimalPlaces
+	}
+
+	return decimalPlaces - x.exponent()
+}
+
+/*
+For internal use
+Reports whether x^3 is negligible against x at decimalPlaces significant digits, so function close to x near zero is x itself
+*/
+func tinyArgument(x *BigFloat, decimalPlaces int) bool {
+	return !x.IsInt64(0) && 2*x.exponent() < -(decimalPlaces+maxGuardDigits+1)
+}
+
+/*
+Calculates hyperbolic sine of BigFloat number with RoundOption:
+
+	decimalPlaces - target decimal places (default is 16)
+	roundingMode - rounding mode of result (default is RoundHalfUp)
+
+Series is used for small arguments, so there is no cancellation near zero.
+For |x| < 10^-(decimalPlaces/2) result has decimalPlaces significant digits (e.g. sinh(1e-30) is 1.000000000000000e-30 with default options).
+*/
+func (f *BigFloat) Sinh(x *BigFloat, options ...RoundOption) (*BigFloat, error) {
+	ro, err := mathOptions(options, x)
+	if err != nil {
+		return nil, err
+	}
+	tiny := tinyArgument(x, ro.decimalPlaces)
+	ro.decimalPlaces = smallArgumentDecimals(x, ro.decimalPlaces)
+
+	r, err := roundResult(ro, func(decimals int) (*BigFloat, error) {
+		if tiny {
+			return x.Copy(), nil
+		}
+
+		s, _, err := sinhCosh(x, decimals)
+
+		return s, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	f.analysis = r.analysis
+
+	return f, nil
+}
+
+/*
+Calculates hyperbolic cosine of BigFloat number with RoundOption:
+
+	decimalPlaces - target decimal places (default is 16)
+	roundingMode - rounding mode of result (default is RoundHalfUp)
+*/
+func (f *BigFloat) Cosh(x *BigFloat, options ...RoundOption) (*BigFloat, error) {
+	ro, err := mathOptions(options, x)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := roundResult(ro, func(decimals int) (*BigFloat, error) {
+		_, c, err := sinhCosh(x, decimals)
+
+		return c, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	f.analysis = r.analysis
+
+	return f, nil
+}
+
+/*
+Calculates hyperbolic tangent of BigFloat number with RoundOption:
+
+	decimalPlaces - target decimal places (default is 16)
+	roundingMode - rounding mode of result (default is RoundHalfUp)
+
+For |x| >= 1 tanh(|x|) = (1 - e^-2|x|) / (1 + e^-2|x|), so large arguments don't need large powers.
+For |x| < 10^-(decimalPlaces/2) result has decimalPlaces significant digits (see Sinh).
+*/
+func (f *BigFloat) Tanh(x *BigFloat, options ...RoundOption) (*BigFloat, error) {
+	ro, err := mathOptions(options, x)
+	if err != nil {
+		return nil, err
+	}
+	tiny := tinyArgument(x, ro.decimalPlaces)
+	ro.decimalPlaces = smallArgumentDecimals(x, ro.decimalPlaces)
+
+	r, _ := roundResult(ro, func(wp int) (*BigFloat, error) {
+		if tiny {
+			return x.Copy(), nil
+		}
+
+		if x.CompareAbs(SetInt64(1)) < 0 {
+			s, c, _ := sinhCosh(x, wp)
+
+			return divWP(s, c, wp), nil
+		}
+
+		e, _ := exp(x.Copy().Abs().MulInt64(-2), wp) // e^-2|x| is never too large
+		one := SetInt64(1)
+
+		return divWP(New().Sub(one, e), New().Add(one, e), wp).Sign(x.GetSign()), nil
+	})
+	f.analysis = r.analysis
+
+	return f, nil
+}
+
+/*
+Calculates inverse hyperbolic sine of BigFloat number as asinh(x) = sign(x) * ln(|x| + sqrt(x^2 + 1)) with RoundOption:
+
+	decimalPlaces - target decimal places (default is 16)
+	roundingMode - rounding mode of result (default is RoundHalfUp)
+
+For |x| <= 0.5 asinh(x) = atanh(x / sqrt(x^2 + 1)) is calculated by series.
+For |x| < 10^-(decimalPlaces/2) result has decimalPlaces significant digits (see Sinh).
+*/
+func (f *BigFloat) Asinh(x *BigFloat, options ...RoundOption) (*BigFloat, error) {
+	ro, err := mathOptions(options, x)
+	if err != nil {
+		return nil, err
+	}
+	tiny := tinyArgument(x, ro.decimalPlaces)
+	ro.decimalPlaces = smallArgumentDecimals(x, ro.decimalPlaces)
+
+	a := x.Copy().Abs()
+	half, _ := SetString("0.5")
+	r, _ := roundResult(ro, func(wp int) (*BigFloat, error) {
+		if tiny {
+			return x.Copy(), nil
+		}
+
+		if a.Compare(half) <= 0 { // asinh(x) = atanh(x / sqrt(x^2 + 1)), there is no cancellation in ln(1 + x) near zero
+			s, _, _ := New().Sqrt(New().Add(mulWP(x, x, wp+guardDigits), SetInt64(1)), WithDecimalPlaces(wp+guardDigits))
+
+			return atanhSeries(divWP(x, s, wp+guardDigits), wp), nil
+		}
+
+		s, _, _ := New().Sqrt(New().Add(New().Mul(a, a), SetInt64(1)), WithDecimalPlaces(wp))
+
+		return ln(New().Add(a, s), wp).Sign(x.GetSign()), nil
+	})
+	f.analysis = r.analysis
+
+	return f, nil
+}
+
+/*
+Calculates inverse hyperbolic cosine of BigFloat number as acosh(x) = ln(x + sqrt(x^2 - 1)) with RoundOption:
+
+	decimalPlaces - target decimal places (default is 16)
+	roundingMode - rounding mode of result (default is RoundHalfUp)
+
+Returns error for argument less then 1.
+*/
+func (f *BigFloat) Acosh(x *BigFloat, options ...RoundOption) (*BigFloat, error) {
+	ro, err := mathOptions(options, x)
+	if err != nil {
+		return nil, err
+	}
+
+	if x.Compare(SetInt64(1)) < 0 {
+		return nil, fmt.Errorf("ERROR: Argument is less then 1")
+	}
+
+	r, _ := roundResult(ro, func(wp int) (*BigFloat, error) {
+		s, _, _ := New().Sqrt(New().Sub(New().Mul(x, x), SetInt64(1)), WithDecimalPlaces(wp))
+
+		return ln(New().Add(x, s), wp), nil
+	})
+	f.analysis = r.analysis
+
+	return f, nil
+}
+
+/*
+Calculates inverse hyperbolic tangent of BigFloat number with RoundOption:
+
+	decimalPlaces - target decimal places (default is 16)
+	roundingMode - rounding mode of result (default is RoundHalfUp)
+
+For |x| <= 0.5 series is used, otherwise atanh(x) = ln((1 + x) / (1 - x)) / 2.
+For |x| < 10^-(decimalPlaces/2) result has decimalPlaces significant digits (see Sinh).
+Returns error for argument out of range (-1, 1).
+*/
+func (f *BigFloat) Atanh(x *BigFloat, options ...RoundOption) (*BigFloat, error) {
+	ro, err := mathOptions(options, x)
+	if err != nil {
+		return nil, err
+	}
+	tiny := tinyArgument(x, ro.decimalPlaces)
+	ro.decimalPlaces = smallArgumentDecimals(x, ro.decimalPlaces)
+
+	one := SetInt64(1)
+	if x.CompareAbs(one) >= 0 {
+		return nil, fmt.Errorf("ERROR: Argument is out of range (-1, 1)")
+	}
+
+	half, _ := SetString("0.5")
+	r, _ := roundResult(ro, func(wp int) (*BigFloat, error) {
+		if tiny {
+			return x.Copy(), nil
+		}
+
+		if x.CompareAbs(half) <= 0 {
+			return atanhSeries(x, wp), nil
+		}
+
+		q := New()
+		q.Div(New().Add(one, x), New().Sub(one, x), WithDivDecimalPlaces(wp+x.decimals())) // 1 - x can be very small
+
+		return divWP(ln(q, wp+1), SetInt64(2), wp), nil
+	})
+	f.analysis = r.analysis
+
+	return f, nil
+}
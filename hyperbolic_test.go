@@ -0,0 +1,253 @@
+package bigfloat
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSinhCoshTanh(t *testing.T) {
+	var cases = []struct {
+		param        string
+		decimals     int
+		expectedSinh string
+		expectedCosh string
+		expectedTanh string
+	}{
+		{"0", 5, "0.00000", "1.00000", "0.00000"},
+		{"1e-30", 40, "0.000000000000000000000000000001000000000000000000000000000000000000000", "1.0000000000000000000000000000000000000000", "0.000000000000000000000000000001000000000000000000000000000000000000000"},
+		{"0.5", 30, "0.521095305493747361622425626411", "1.127625965206380785226225161403", "0.462117157260009758502318483644"},
+		{"-0.5", 20, "-0.52109530549374736162", "1.12762596520638078523", "-0.46211715726000975850"},
+		{"1", 25, "1.1752011936438014568823819", "1.5430806348152437784779056", "0.7615941559557648881194583"},
+		{"-3.75", 20, "-21.24878212710338697364", "21.27229987295939608188", "-0.99889444272615280097"},
+		{"20", 10, "242582597.7048951380", "242582597.7048951400", "1.0000000000"},
+		{"-0.001", 20, "-0.00100000016666667500", "1.00000050000004166667", "-0.00099999966666680000"},
+	}
+	fmt.Printf("\nTestSinhCoshTanh...\n")
+	for _, c := range cases {
+		fmt.Printf("sinh, cosh, tanh(%v, %v) = ", c.param, c.decimals)
+		n1, err := createBigFloat(t, c.param)
+		if err != nil {
+			continue
+		}
+
+		n2, n3, n4 := New(), New(), New()
+		_, err1 := n2.Sinh(n1, WithDecimalPlaces(c.decimals))
+		_, err2 := n3.Cosh(n1, WithDecimalPlaces(c.decimals))
+		_, err3 := n4.Tanh(n1, WithDecimalPlaces(c.decimals))
+		if err1 != nil || err2 != nil || err3 != nil {
+			t.Errorf("Hyperbolic function error %v %v %v", err1, err2, err3)
+			continue
+		}
+
+		expectedStr := fmt.Sprintf("%v, %v, %v", c.expectedSinh, c.expectedCosh, c.expectedTanh)
+		result := fmt.Sprintf("%v, %v, %v", n2.String(), n3.String(), n4.String())
+
+		fmt.Printf("%v\n", result)
+		printResult(t, result, expectedStr, nil)
+	}
+}
+
+func TestHyperbolicSmallDefault(t *testing.T) {
+	var cases = []struct {
+		fn       string
+		param    string
+		expected string
+	}{
+		{"sinh", "1e-30", "0.000000000000000000000000000001000000000000000"},
+		{"sinh", "1e-5", "0.0000100000000002"},
+		{"tanh", "-2.5e-20", "-0.00000000000000000002500000000000000"},
+		{"asinh", "1e-9", "0.000000001000000000000000"},
+		{"atanh", "1e-9", "0.000000001000000000000000"},
+		{"asinh", "0.3", "0.2956730475634224"},
+		{"asinh", "-0.5", "-0.4812118250596034"},
+	}
+	fmt.Printf("\nTestHyperbolicSmallDefault...\n")
+	for _, c := range cases {
+		fmt.Printf("%v(%v) = ", c.fn, c.param)
+		n1, err := createBigFloat(t, c.param)
+		if err != nil {
+			continue
+		}
+
+		n2 := New()
+		switch c.fn {
+		case "sinh":
+			_, err = n2.Sinh(n1)
+		case "tanh":
+			_, err = n2.Tanh(n1)
+		case "asinh":
+			_, err = n2.Asinh(n1)
+		case "atanh":
+			_, err = n2.Atanh(n1)
+		}
+
+		result := n2.String()
+		fmt.Printf("%v\n", result)
+		printResult(t, result, c.expected, err)
+	}
+}
+
+func TestHyperbolicTiny(t *testing.T) {
+	var cases = []struct {
+		fn       string
+		param    string
+		expected string
+	}{
+		{"sinh", "-1e-2000", "-1E-2000, 2015"},
+		{"tanh", "1e-2000", "1E-2000, 2015"},
+		{"asinh", "-1e-100000", "-1E-100000, 100015"},
+		{"atanh", "1e-100000", "1E-100000, 100015"},
+	}
+	fmt.Printf("\nTestHyperbolicTiny...\n")
+	for _, c := range cases {
+		fmt.Printf("%v(%v) = ", c.fn, c.param)
+		n1, err := createBigFloat(t, c.param)
+		if err != nil {
+			continue
+		}
+
+		n2 := New()
+		switch c.fn {
+		case "sinh":
+			_, err = n2.Sinh(n1)
+		case "tanh":
+			_, err = n2.Tanh(n1)
+		case "asinh":
+			_, err = n2.Asinh(n1)
+		case "atanh":
+			_, err = n2.Atanh(n1)
+		}
+
+		result := fmt.Sprintf("%v, %v", n2.StringWith(Scientific(true)), n2.decimals())
+		fmt.Printf("%v\n", result)
+		printResult(t, result, c.expected, err)
+	}
+}
+
+func TestTanhLarge(t *testing.T) {
+	var cases = []struct {
+		param    string
+		decimals int
+		expected string
+	}{
+		{"50", 25, "1.0000000000000000000000000"},
+		{"-100", 20, "-1.00000000000000000000"},
+		{"1e10", 10, "1.0000000000"},
+	}
+	fmt.Printf("\nTestTanhLarge...\n")
+	for _, c := range cases {
+		fmt.Printf("tanh(%v, %v) = ", c.param, c.decimals)
+		n1, err := createBigFloat(t, c.param)
+		if err != nil {
+			continue
+		}
+
+		n2 := New()
+		_, err = n2.Tanh(n1, WithDecimalPlaces(c.decimals))
+
+		expectedStr := c.expected
+		result := n2.String()
+
+		fmt.Printf("%v\n", result)
+		printResult(t, result, expectedStr, err)
+	}
+}
+
+func TestInverseHyperbolic(t *testing.T) {
+	var cases = []struct {
+		fn       string
+		param    string
+		decimals int
+		expected string
+	}{
+		{"asinh", "0", 5, "0.00000"},
+		{"asinh", "1e-30", 40, "0.000000000000000000000000000001000000000000000000000000000000000000000"},
+		{"asinh", "0.5", 30, "0.481211825059603447497758913424"},
+		{"asinh", "-2", 20, "-1.44363547517881034249"},
+		{"asinh", "1000000", 15, "14.508657738524469"},
+		{"acosh", "1", 5, "0.00000"},
+		{"acosh", "1.5", 30, "0.962423650119206894995517826849"},
+		{"acosh", "10", 20, "2.99322284612638089791"},
+		{"acosh", "1.000001", 20, "0.00141421344452199137"},
+		{"atanh", "0", 5, "0.00000"},
+		{"atanh", "1e-30", 40, "0.000000000000000000000000000001000000000000000000000000000000000000000"},
+		{"atanh", "0.5", 30, "0.549306144334054845697622618461"},
+		{"atanh", "-0.75", 20, "-0.97295507452765665255"},
+		{"atanh", "0.999999", 20, "7.25432861926204720674"},
+		{"atanh", "0.3", 16, "0.3095196042031117"},
+	}
+	fmt.Printf("\nTestInverseHyperbolic...\n")
+	for _, c := range cases {
+		fmt.Printf("%v(%v, %v) = ", c.fn, c.param, c.decimals)
+		n1, err := createBigFloat(t, c.param)
+		if err != nil {
+			continue
+		}
+
+		n2 := New()
+		switch c.fn {
+		case "asinh":
+			_, err = n2.Asinh(n1, WithDecimalPlaces(c.decimals))
+		case "acosh":
+			_, err = n2.Acosh(n1, WithDecimalPlaces(c.decimals))
+		case "atanh":
+			_, err = n2.Atanh(n1, WithDecimalPlaces(c.decimals))
+		}
+
+		expectedStr := c.expected
+		result := n2.String()
+
+		fmt.Printf("%v\n", result)
+		printResult(t, result, expectedStr, err)
+	}
+}
+
+func TestErrorsHyperbolic(t *testing.T) {
+	var cases = []struct {
+		fn    string
+		param string
+	}{
+		{"acosh", "0.999"},
+		{"acosh", "-1"},
+		{"atanh", "1"},
+		{"atanh", "-1.5"},
+		{"sinh", "10000000"},
+		{"sinh", "1e1001"},
+		{"cosh", "-1e1001"},
+	}
+
+	fmt.Printf("\nTestErrorsHyperbolic...\n")
+	for _, c := range cases {
+		fmt.Printf("%v(%v) = ", c.fn, c.param)
+		n1, err := createBigFloat(t, c.param)
+		if err != nil {
+			continue
+		}
+		func() {
+			defer func() {
+				if err := recover(); err != nil {
+					fmt.Printf("\nOK: panic occurred: %v\n", err)
+				}
+			}()
+
+			n2 := New()
+			switch c.fn {
+			case "acosh":
+				_, err = n2.Acosh(n1)
+			case "atanh":
+				_, err = n2.Atanh(n1)
+			case "sinh":
+				_, err = n2.Sinh(n1)
+			case "cosh":
+				_, err = n2.Cosh(n1)
+			}
+			if err != nil {
+				panic(err)
+			}
+
+			errorStr := fmt.Sprintf("%v should raise panic", c)
+			fmt.Printf("\n" + errorStr + "\n")
+			t.Errorf(errorStr)
+		}()
+	}
+}
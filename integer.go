@@ -0,0 +1,191 @@
+/*
+Copyright 2023 Tihomir Magdic. All rights reserved.
+Use of this source code is governed by a BSD-style
+license that can be found in the LICENSE file.
+*/
+
+package bigfloat
+
+import (
+	"fmt"
+)
+
+/*
+For internal use
+Returns error if any of numbers is not finite integer (decimals must be zeroes)
+*/
+func checkInt(args ...*BigFloat) error {
+	if err := checkFinite(args...); err != nil {
+		return err
+	}
+
+	for _, a := range args {
+		if !a.isInt() {
+			return fmt.Errorf("ERROR: Argument is not an integer")
+		}
+	}
+
+	return nil
+}
+
+/*
+For internal use
+Returns copy of integer BigFloat number without decimals
+*/
+func intCopy(a *BigFloat) *BigFloat {
+	return a.clone().SetDecimals(0)
+}
+
+/*
+For internal use
+Greatest common divisor of non negative integers without decimals (Euclid's algorithm)
+*/
+func gcd(a, b *BigFloat) *BigFloat {
+	for !b.IsInt64(0) {
+		_, r, _ := New().DivMod(a, b)
+		a, b = b, r
+	}
+
+	return a
+}
+
+/*
+Calculates greatest common divisor of integer BigFloat numbers
+Result is non negative and without decimals (gcd(0, 0) = 0)
+Returns error for non integer numbers
+*/
+func (f *BigFloat) GCD(a, b *BigFloat) (*BigFloat, error) {
+	if err := checkInt(a, b); err != nil {
+		return nil, err
+	}
+
+	f.analysis = gcd(intCopy(a).Abs(), intCopy(b).Abs()).analysis
+
+	return f, nil
+}
+
+/*
+Calculates least common multiple of integer BigFloat numbers
+Result is non negative and without decimals (lcm(0, x) = 0)
+Returns error for non integer numbers
+*/
+func (f *BigFloat) LCM(a, b *BigFloat) (*BigFloat, error) {
+	if err := checkInt(a, b); err != nil {
+		return nil, err
+	}
+
+	x, y := intCopy(a).Abs(), intCopy(b).Abs()
+	if x.IsInt64(0) || y.IsInt64(0) {
+		f.SetInt64(0)
+
+		return f, nil
+	}
+
+	q := New()
+	q.DivMod(x, gcd(x, y)) // a / gcd(a, b) * b keeps intermediate result small
+	f.Mul(q, y)
+
+	return f, nil
+}
+
+/*
+Calculates greatest common divisor g of integer BigFloat numbers and Bezout coefficients x and y,
+so that a * x + b * y = g (extended Euclid's algorithm)
+Result g is non negative, all results are without decimals
+Returns error for non integer numbers
+*/
+func ExtendedGCD(a, b *BigFloat) (*BigFloat, *BigFloat, *BigFloat, error) {
+	if err := checkInt(a, b); err != nil {
+		return nil, nil, nil, err
+	}
+
+	oldR, r := intCopy(a).Abs(), intCopy(b).Abs()
+	oldS, s := SetInt64(1), New()
+	oldT, t := New(), SetInt64(1)
+
+	for !r.IsInt64(0) {
+		q := New()
+		_, rem, _ := q.DivMod(oldR, r)
+		oldR, r = r, rem
+		oldS, s = s, New().Sub(oldS, New().Mul(q, s))
+		oldT, t = t, New().Sub(oldT, New().Mul(q, t))
+	}
+
+	if a.GetSign() < 0 { // coefficients for absolute values
+		oldS.Neg()
+	}
+	if b.GetSign() < 0 {
+		oldT.Neg()
+	}
+
+	return oldR, oldS, oldT, nil
+}
+
+/*
+For internal use
+Returns residue of integer BigFloat number modulo m as nat (0 <= residue < m, also for negative numbers)
+*/
+func residue(a *BigFloat, m nat) nat {
+	r := natMod(toNat(a), m)
+	if a.analysis.Sign < 0 && len(r) > 0 {
+		r = natSub(m, r)
+	}
+
+	return r
+}
+
+/*
+Calculates modular exponentiation base^exp mod m of integer BigFloat numbers
+Result is non negative and less than |m| (also for negative base)
+Negative exponent uses modular inverse of base (error if inverse doesn't exist)
+Every multiplication is reduced modulo m, so numbers stay below m^2 even for very large exponents
+Returns error for non integer numbers and for m = 0
+*/
+func (f *BigFloat) ModPow(base, exp, m *BigFloat) (*BigFloat, error) {
+	if err := checkInt(base, exp, m); err != nil {
+		return nil, err
+	}
+
+	mod := toNat(m)
+	if len(mod) == 0 {
+		return nil, fmt.Errorf("ERROR: Division by zero")
+	}
+
+	x := residue(base, mod)
+	if exp.GetSign() < 0 {
+		inverse, ok := natModInverse(x, mod)
+		if !ok {
+			return nil, fmt.Errorf("ERROR: Modular inverse does not exist")
+		}
+		x = inverse
+	}
+
+	f.analysis = natModPow(x, toNat(exp), mod).bigFloat().analysis
+
+	return f, nil
+}
+
+/*
+Calculates modular inverse x of integer BigFloat number a modulo m, so that a * x mod m = 1
+Result is non negative and less than |m| (also for negative a)
+Returns error for non integer numbers, for m = 0 and if inverse doesn't exist (a and m are not coprime)
+*/
+func (f *BigFloat) ModInverse(a, m *BigFloat) (*BigFloat, error) {
+	if err := checkInt(a, m); err != nil {
+		return nil, err
+	}
+
+	mod := toNat(m)
+	if len(mod) == 0 {
+		return nil, fmt.Errorf("ERROR: Division by zero")
+	}
+
+	inverse, ok := natModInverse(residue(a, mod), mod)
+	if !ok {
+		return nil, fmt.Errorf("ERROR: Modular inverse does not exist")
+	}
+
+	f.analysis = inverse.bigFloat().analysis
+
+	return f, nil
+}
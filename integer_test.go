@@ -0,0 +1,246 @@
+package bigfloat
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestGCD(t *testing.T) {
+	var cases = []struct {
+		param1      string
+		param2      string
+		expectedGCD string
+		expectedLCM string
+	}{
+		{"240", "46", "2", "5520"},
+		{"-240", "46", "2", "5520"},
+		{"12", "18", "6", "36"},
+		{"17", "5", "1", "85"},
+		{"0", "5", "5", "0"},
+		{"5", "0", "5", "0"},
+		{"0", "0", "0", "0"},
+		{"6.00", "-4", "2", "12"},
+		{"18446744073709551616", "12157665459056928801", "1", "224269343257001716702690972139746492416"},
+		{"123456789012345678901234567890", "987654321098765432109876543210", "9000000000900000000090", "13548070124980948012498094801236261410"},
+	}
+	fmt.Printf("\nTestGCD...\n")
+	for _, c := range cases {
+		fmt.Printf("gcd(%v, %v), lcm(%v, %v) = ", c.param1, c.param2, c.param1, c.param2)
+		n1, n2, err := create2BigFloats(t, c.param1, c.param2)
+		if err != nil {
+			continue
+		}
+
+		g := New()
+		_, err = g.GCD(n1, n2)
+		if err != nil {
+			fmt.Printf("%v\n", err)
+			t.Errorf("GCD error %v", err)
+			continue
+		}
+		l := New()
+		_, err = l.LCM(n1, n2)
+
+		result := fmt.Sprintf("%v, %v", g, l)
+		fmt.Printf("%v\n", result)
+		printResult(t, result, fmt.Sprintf("%v, %v", c.expectedGCD, c.expectedLCM), err)
+	}
+}
+
+func TestExtendedGCD(t *testing.T) {
+	var cases = []struct {
+		param1   string
+		param2   string
+		expected string
+	}{
+		{"240", "46", "2, -9, 47"},
+		{"-240", "46", "2, 9, 47"},
+		{"240", "-46", "2, -9, -47"},
+		{"17", "5", "1, -2, 7"},
+		{"0", "5", "5, 0, 1"},
+		{"5", "0", "5, 1, 0"},
+		{"0", "0", "0, 1, 0"},
+		{"18446744073709551616", "12157665459056928801", "1, 3997565229372176830, -6065478849745282079"},
+		{"123456789012345678901234567890", "987654321098765432109876543210", "9000000000900000000090, -8, 1"},
+	}
+	fmt.Printf("\nTestExtendedGCD...\n")
+	for _, c := range cases {
+		fmt.Printf("extendedGCD(%v, %v) = ", c.param1, c.param2)
+		n1, n2, err := create2BigFloats(t, c.param1, c.param2)
+		if err != nil {
+			continue
+		}
+
+		g, x, y, err := ExtendedGCD(n1, n2)
+		if err != nil {
+			fmt.Printf("%v\n", err)
+			t.Errorf("ExtendedGCD error %v", err)
+			continue
+		}
+
+		result := fmt.Sprintf("%v, %v, %v", g, x, y)
+		fmt.Printf("%v\n", result)
+		printResult(t, result, c.expected, err)
+
+		if New().Add(New().Mul(n1, x), New().Mul(n2, y)).Compare(g) != 0 {
+			t.Errorf("a * x + b * y should be %v", g)
+		}
+	}
+}
+
+func TestErrorsGCD(t *testing.T) {
+	var cases = []struct {
+		param1 string
+		param2 string
+	}{
+		{"1.5", "3"},
+		{"4", "0.01"},
+		{"NaN", "3"},
+		{"4", "Inf"},
+	}
+	fmt.Printf("\nTestErrorsGCD...\n")
+	for _, c := range cases {
+		fmt.Printf("gcd(%v, %v) = ", c.param1, c.param2)
+		n1, n2, err := create2BigFloats(t, c.param1, c.param2)
+		if err != nil {
+			continue
+		}
+
+		_, errGCD := New().GCD(n1, n2)
+		_, errLCM := New().LCM(n1, n2)
+		_, _, _, errExt := ExtendedGCD(n1, n2)
+		fmt.Printf("%v\n", errGCD)
+		if errGCD == nil || errLCM == nil || errExt == nil {
+			t.Errorf("gcd(%v, %v) should return error", c.param1, c.param2)
+		}
+	}
+}
+
+func TestModPow(t *testing.T) {
+	var cases = []struct {
+		base     string
+		exp      string
+		mod      string
+		expected string
+	}{
+		{"4", "13", "497", "445"},
+		{"-4", "13", "497", "52"},
+		{"4", "13", "-497", "445"},
+		{"4", "0", "497", "1"},
+		{"0", "0", "7", "1"},
+		{"0", "5", "7", "0"},
+		{"5", "3", "1", "0"},
+		{"2", "-1", "7", "4"},
+		{"3", "-2", "7", "4"},
+		{"123456789", "987654321", "1000000007", "652541198"},
+		{"170141183460469231731687303715884105727", "1000000000000000000000000000007", "10000000000000000000000000000000000000009", "4451483995749642691989206609865525316318"},
+	}
+	fmt.Printf("\nTestModPow...\n")
+	for _, c := range cases {
+		fmt.Printf("modPow(%v, %v, %v) = ", c.base, c.exp, c.mod)
+		base, exp, err := create2BigFloats(t, c.base, c.exp)
+		if err != nil {
+			continue
+		}
+		mod, err := createBigFloat(t, c.mod)
+		if err != nil {
+			continue
+		}
+
+		result := New()
+		_, err = result.ModPow(base, exp, mod)
+		fmt.Printf("%v\n", result)
+		printResult(t, result.String(), c.expected, err)
+	}
+}
+
+func TestModPowLarge(t *testing.T) {
+	fmt.Printf("\nTestModPowLarge...\n")
+	for _, e := range []int64{521, 3217} { // Mersenne primes 2^e - 1 (3217 has 969 digits)
+		p := New()
+		p.PowInt(SetInt64(2), e)
+		p.Sub(p, SetInt64(1))
+
+		result := New()
+		_, err := result.ModPow(SetInt64(3), New().Sub(p, SetInt64(1)), p) // Fermat's little theorem
+		fmt.Printf("modPow(3, 2^%d - 2, 2^%d - 1) = %v\n", e, e, result)
+		printResult(t, result.String(), "1", err)
+
+		inverse := New()
+		_, err = inverse.ModInverse(SetInt64(3), p)
+		check := New()
+		check.ModPow(inverse, SetInt64(-1), p)
+		printResult(t, check.String(), "3", err)
+	}
+}
+
+func TestModInverse(t *testing.T) {
+	var cases = []struct {
+		param1   string
+		param2   string
+		expected string
+	}{
+		{"3", "11", "4"},
+		{"-3", "11", "7"},
+		{"3", "-11", "4"},
+		{"10", "17", "12"},
+		{"17", "3120", "2753"},
+		{"1", "1", "0"},
+		{"14", "15", "14"},
+		{"123456789012345678901234567890", "1000000000000000000000000000057", "702408638268987573765028300612"},
+	}
+	fmt.Printf("\nTestModInverse...\n")
+	for _, c := range cases {
+		fmt.Printf("modInverse(%v, %v) = ", c.param1, c.param2)
+		n1, n2, err := create2BigFloats(t, c.param1, c.param2)
+		if err != nil {
+			continue
+		}
+
+		result := New()
+		_, err = result.ModInverse(n1, n2)
+		fmt.Printf("%v\n", result)
+		printResult(t, result.String(), c.expected, err)
+	}
+}
+
+func TestErrorsModPow(t *testing.T) {
+	var cases = []struct {
+		base string
+		exp  string
+		mod  string
+	}{
+		{"1.5", "2", "7"},
+		{"2", "0.5", "7"},
+		{"2", "3", "7.1"},
+		{"2", "3", "0"},
+		{"2", "-1", "8"},
+		{"NaN", "3", "7"},
+		{"2", "Inf", "7"},
+	}
+	fmt.Printf("\nTestErrorsModPow...\n")
+	for _, c := range cases {
+		fmt.Printf("modPow(%v, %v, %v) = ", c.base, c.exp, c.mod)
+		base, exp, err := create2BigFloats(t, c.base, c.exp)
+		if err != nil {
+			continue
+		}
+		mod, err := createBigFloat(t, c.mod)
+		if err != nil {
+			continue
+		}
+
+		_, err = New().ModPow(base, exp, mod)
+		fmt.Printf("%v\n", err)
+		if err == nil {
+			t.Errorf("modPow(%v, %v, %v) should return error", c.base, c.exp, c.mod)
+		}
+	}
+
+	fmt.Printf("modInverse(6, 9) = ")
+	_, err := New().ModInverse(SetInt64(6), SetInt64(9))
+	fmt.Printf("%v\n", err)
+	if err == nil {
+		t.Errorf("modInverse(6, 9) should return error")
+	}
+}
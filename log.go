@@ -0,0 +1,245 @@
+/*
+Copyright 2023 Tihomir Magdic. All rights reserved.
+Use of this source code is governed by a BSD-style
+license that can be found in the LICENSE file.
+*/
+
+package bigfloat
+
+import (
+	"fmt"
+)
+
+/*
+For internal use
+Calculates atanh(z) = z + z^3/3 + z^5/5 + ... for |z| < 1 rounded to n decimals in every step
+*/
+func atanhSeries(z *BigFloat, n int) *BigFloat {
+	z2 := mulWP(z, z, n)
+	p := z.Copy()
+	sum := z.Copy()
+	for k := int64(3); ; k += 2 {
+		p = mulWP(p, z2, n)
+		term := divWP(p, SetInt64(k), n)
+		if term.IsInt64(0) {
+			break
+		}
+		sum = New().Add(sum, term)
+	}
+
+	return sum
+}
+
+/*
+For internal use
+Returns exponent e of BigFloat number x = t * 10^e where t is in [0.1, 1)
+Number must not be 0
+*/
+func (f *BigFloat) exponent() int {
+	if f.isCompact() { // coefficient has no leading zeroes
+		return f.analysis.Len + f.analysis.Exponent
+	}
+
+	p := 0 // position of first non zero digit
+	for p < f.analysis.Len && f.analysis.Norm[p] == '0' {
+		p++
+	}
+
+	return f.analysis.Len - f.analysis.Decimals - p
+}
+
+/*
+For internal use
+Calculates natural logarithm of positive BigFloat number with absolute error less then 10^-decimals (result is not rounded)
+
+Argument is reduced as x = u * 10^e / 2^j with u in [0.75, 1.5), so ln(x) = e * ln(10) - j * ln(2) + 2 * atanh((u - 1) / (u + 1))
+*/
+func ln(x *BigFloat, decimals int) *BigFloat {
+	if x.IsInt64(1) {
+		return New()
+	}
+
+	e := x.exponent() // x = t * 10^e with t in [0.1, 1)
+
+	t := x.Copy()
+	if e > 0 {
+		t.Div10(e)
+	} else if e < 0 {
+		t.Mul10(-e)
+	}
+
+	wp := decimals + digitsInt64(int64(maxInt(e, -e))) + guardDigits
+
+	j := 0
+	threshold, _ := SetString("0.75")
+	for t.Compare(threshold) < 0 { // t * 2^j in [0.75, 1.5)
+		t = New().Mul(t, SetInt64(2))
+		j++
+	}
+
+	one := SetInt64(1)
+	z := divWP(New().Sub(t, one), New().Add(t, one), wp)
+	result := atanhSeries(z, wp).MulInt64(2)
+
+	if e != 0 {
+		result = New().Add(result, mulWP(ln10(wp), SetInt64(int64(e)), wp))
+	}
+	if j != 0 {
+		result = New().Sub(result, mulWP(ln2(wp), SetInt64(int64(j)), wp))
+	}
+
+	return result
+}
+
+/*
+For internal use
+Checks argument of logarithm
+*/
+func checkLogArgument(x *BigFloat) error {
+	if x.IsInt64(0) {
+		return fmt.Errorf("ERROR: Logarithm of zero")
+	} else if x.GetSign() < 0 {
+		return fmt.Errorf("ERROR: Logarithm of negative number")
+	}
+
+	return nil
+}
+
+/*
+For internal use
+Calculates ln(x) / ln(base) rounded with RoundOption, where lnBase is function which calculates ln(base) with absolute error less then 10^-decimals
+*/
+func (f *BigFloat) logBase(x *BigFloat, ro roundOptionsType, lnBase func(int) *BigFloat) *BigFloat {
+	wp := ro.decimalPlaces + guardDigits
+	b := lnBase(wp)
+
+	extra := 0
+	if z := -b.exponent(); z > 0 { // small ln(base) (base near 1) increases error of quotient
+		extra += 2 * z
+	}
+	n, _ := ln(x, 2).int64Part() // error of ln(base) is multiplied by ln(x)
+	extra += digitsInt64(int64(maxInt(int(n), -int(n))))
+
+	r, _ := roundResult(ro, func(decimals int) (*BigFloat, error) {
+		wp := decimals + extra
+
+		return divWP(ln(x, wp), lnBase(wp), wp), nil
+	})
+	f.analysis = r.analysis
+
+	return f
+}
+
+/*
+Calculates natural logarithm of BigFloat number with RoundOption:
+
+	decimalPlaces - target decimal places (default is 16)
+	roundingMode - rounding mode of result (default is RoundHalfUp)
+
+Returns error for zero or negative number.
+*/
+func (f *BigFloat) Ln(x *BigFloat, options ...RoundOption) (*BigFloat, error) {
+	ro, err := mathOptions(options, x)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkLogArgument(x); err != nil {
+		return nil, err
+	}
+
+	r, _ := roundResult(ro, func(decimals int) (*BigFloat, error) {
+		return ln(x, decimals), nil
+	})
+	f.analysis = r.analysis
+
+	return f, nil
+}
+
+/*
+Calculates base 10 logarithm of BigFloat number with RoundOption:
+
+	decimalPlaces - target decimal places (default is 16)
+	roundingMode - rounding mode of result (default is RoundHalfUp)
+
+Result is exact integer for exact powers of 10 (e.g. log10(1000) = 3, log10(0.01) = -2).
+Returns error for zero or negative number.
+*/
+func (f *BigFloat) Log10(x *BigFloat, options ...RoundOption) (*BigFloat, error) {
+	ro, err := mathOptions(options, x)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkLogArgument(x); err != nil {
+		return nil, err
+	}
+
+	if x.isPow10() { // exact result without calculation
+		f.SetInt64(int64(x.exponent() - 1))
+
+		return f, nil
+	}
+
+	return f.logBase(x, ro, ln10), nil
+}
+
+/*
+Calculates base 2 logarithm of BigFloat number with RoundOption:
+
+	decimalPlaces - target decimal places (default is 16)
+	roundingMode - rounding mode of result (default is RoundHalfUp)
+
+Returns error for zero or negative number.
+*/
+func (f *BigFloat) Log2(x *BigFloat, options ...RoundOption) (*BigFloat, error) {
+	ro, err := mathOptions(options, x)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkLogArgument(x); err != nil {
+		return nil, err
+	}
+
+	return f.logBase(x, ro, ln2), nil
+}
+
+/*
+Calculates logarithm of BigFloat number for given base with RoundOption:
+
+	decimalPlaces - target decimal places (default is 16)
+	roundingMode - rounding mode of result (default is RoundHalfUp)
+
+Returns error for zero or negative number, and for base which is not positive or is 1.
+*/
+func (f *BigFloat) Log(x, base *BigFloat, options ...RoundOption) (*BigFloat, error) {
+	ro, err := mathOptions(options, x, base)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkLogArgument(x); err != nil {
+		return nil, err
+	}
+
+	if base.IsInt64(1) {
+		return nil, fmt.Errorf("ERROR: Logarithm base is 1")
+	} else if err := checkLogArgument(base); err != nil {
+		return nil, fmt.Errorf("ERROR: Logarithm base is not positive")
+	}
+
+	if base.isPow10() && x.isPow10() { // exact result for powers of 10 when possible
+		q := New()
+		_, repDec, _ := q.Div(SetInt64(int64(x.exponent()-1)), SetInt64(int64(base.exponent()-1)))
+		if repDec == 0 {
+			f.analysis = q.analysis
+
+			return f, nil
+		}
+	}
+
+	return f.logBase(x, ro, func(n int) *BigFloat {
+		return ln(base, n)
+	}), nil
+}
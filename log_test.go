@@ -0,0 +1,179 @@
+package bigfloat
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestLn(t *testing.T) {
+	var cases = []struct {
+		param    string
+		decimals int
+		expected string
+	}{
+		{"1", 5, "0.00000"},
+		{"2", 40, "0.6931471805599453094172321214581765680755"},
+		{"10", 30, "2.302585092994045684017991454684"},
+		{"0.5", 20, "-0.69314718055994530942"},
+		{"123456.789", 25, "11.7236464871858809811399590"},
+		{"0.00001234", 20, "-11.30266453948703234873"},
+		{"1.0000001", 20, "0.00000009999999500000"},
+		{"2.718281828459045235360287471353", 25, "1.0000000000000000000000000"},
+		{"1e100", 10, "230.2585092994"},
+	}
+	fmt.Printf("\nTestLn...\n")
+	for _, c := range cases {
+		fmt.Printf("ln(%v, %v) = ", c.param, c.decimals)
+		n1, err := createBigFloat(t, c.param)
+		if err != nil {
+			continue
+		}
+
+		n2 := New()
+		_, err = n2.Ln(n1, WithDecimalPlaces(c.decimals))
+
+		expectedStr := c.expected
+		result := n2.String()
+
+		fmt.Printf("%v\n", result)
+		printResult(t, result, expectedStr, err)
+	}
+}
+
+func TestLog10(t *testing.T) {
+	var cases = []struct {
+		param    string
+		decimals int
+		expected string
+	}{
+		{"1", 5, "0"},
+		{"1000", 5, "3"},
+		{"0.001", 5, "-3"},
+		{"1e1000", 5, "1000"},
+		{"2", 30, "0.301029995663981195213738894724"},
+		{"1234.5", 20, "3.09149109426795108185"},
+		{"0.5", 16, "-0.3010299956639812"},
+		{"99999", 12, "4.999995657033"},
+	}
+	fmt.Printf("\nTestLog10...\n")
+	for _, c := range cases {
+		fmt.Printf("log10(%v, %v) = ", c.param, c.decimals)
+		n1, err := createBigFloat(t, c.param)
+		if err != nil {
+			continue
+		}
+
+		n2 := New()
+		_, err = n2.Log10(n1, WithDecimalPlaces(c.decimals))
+
+		expectedStr := c.expected
+		result := n2.String()
+
+		fmt.Printf("%v\n", result)
+		printResult(t, result, expectedStr, err)
+	}
+}
+
+func TestLog2(t *testing.T) {
+	var cases = []struct {
+		param    string
+		decimals int
+		expected string
+	}{
+		{"2", 10, "1.0000000000"},
+		{"8", 10, "3.0000000000"},
+		{"10", 30, "3.321928094887362347870319429489"},
+		{"0.1", 20, "-3.32192809488736234787"},
+		{"1024.5", 15, "10.000704269011247"},
+	}
+	fmt.Printf("\nTestLog2...\n")
+	for _, c := range cases {
+		fmt.Printf("log2(%v, %v) = ", c.param, c.decimals)
+		n1, err := createBigFloat(t, c.param)
+		if err != nil {
+			continue
+		}
+
+		n2 := New()
+		_, err = n2.Log2(n1, WithDecimalPlaces(c.decimals))
+
+		expectedStr := c.expected
+		result := n2.String()
+
+		fmt.Printf("%v\n", result)
+		printResult(t, result, expectedStr, err)
+	}
+}
+
+func TestLog(t *testing.T) {
+	var cases = []struct {
+		param1   string
+		param2   string
+		decimals int
+		expected string
+	}{
+		{"1000", "100", 10, "1.5"},
+		{"0.1", "100", 10, "-0.5"},
+		{"8", "2", 10, "3.0000000000"},
+		{"100", "3", 20, "4.19180654857876920859"},
+		{"2", "0.5", 15, "-1.000000000000000"},
+		{"10", "1.0001", 12, "23027.002203299704"},
+		{"0.001", "7", 18, "-3.549883987364814980"},
+	}
+	fmt.Printf("\nTestLog...\n")
+	for _, c := range cases {
+		fmt.Printf("log(%v, %v, %v) = ", c.param1, c.param2, c.decimals)
+		n1, n2, err := create2BigFloats(t, c.param1, c.param2)
+		if err != nil {
+			continue
+		}
+
+		n3 := New()
+		_, err = n3.Log(n1, n2, WithDecimalPlaces(c.decimals))
+
+		expectedStr := c.expected
+		result := n3.String()
+
+		fmt.Printf("%v\n", result)
+		printResult(t, result, expectedStr, err)
+	}
+}
+
+func TestErrorsLog(t *testing.T) {
+	var cases = []struct {
+		param1 string
+		param2 string
+	}{
+		{"0", "10"},
+		{"-1", "10"},
+		{"10", "1"},
+		{"10", "0"},
+		{"10", "-2"},
+	}
+
+	fmt.Printf("\nTestErrorsLog...\n")
+	for _, c := range cases {
+		fmt.Printf("log(%v, %v) = ", c.param1, c.param2)
+		n1, n2, err := create2BigFloats(t, c.param1, c.param2)
+		if err != nil {
+			continue
+		}
+		func() {
+			defer func() {
+				if err := recover(); err != nil {
+					fmt.Printf("\nOK: panic occurred: %v\n", err)
+				}
+			}()
+
+			n3 := New()
+			_, err := n3.Log(n1, n2)
+			if err != nil {
+				panic(err)
+			}
+
+			errorStr := fmt.Sprintf("%v should raise panic", c)
+			fmt.Printf("\n" + errorStr + "\n")
+			t.Errorf(errorStr)
+		}()
+	}
+}
@@ -0,0 +1,230 @@
+/*
+Copyright 2023 Tihomir Magdic. All rights reserved.
+Use of this source code is governed by a BSD-style
+license that can be found in the LICENSE file.
+*/
+
+package bigfloat
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+Default number of decimal places for operations which results cannot be represented exactly (e.g. square root)
+*/
+const defaultDecimalPlaces = 16
+
+/*
+For internal use
+Processes RoundOption for mathematical functions, checks decimal places and if arguments are finite numbers
+*/
+func mathOptions(options []RoundOption, args ...*BigFloat) (roundOptionsType, error) {
+	ro := roundOptionsType{
+		decimalPlaces: defaultDecimalPlaces,
+	}
+	for _, option := range options {
+		option(&ro)
+	}
+
+	if ro.decimalPlaces < 0 {
+		return ro, fmt.Errorf("ERROR: Negative decimal places. Decimal places should be 0 or positive")
+	}
+
+	if !ro.roundingMode.valid() {
+		return ro, fmt.Errorf("ERROR: Invalid rounding mode")
+	}
+
+	return ro, checkFinite(args...)
+}
+
+/*
+Maximum number of extra decimals used in internal calculations before result is treated as exact
+*/
+const maxGuardDigits = 40
+
+/*
+For internal use
+Returns number nearest to approximation r at which rounding to decimal places changes (multiple of 10^-decimalPlaces
+for directed rounding modes, half way between two multiples for other rounding modes)
+*/
+func roundingBoundary(r *BigFloat, ro roundOptionsType) *BigFloat {
+	switch ro.roundingMode {
+	case RoundUp, RoundDown, RoundCeiling, RoundFloor:
+		return r.clone().round(ro.decimalPlaces, RoundHalfUp, false)
+	}
+
+	half := SetInt64(5).Div10(ro.decimalPlaces + 1).Sign(r.GetSign())
+
+	return New().Add(r.clone().round(ro.decimalPlaces, RoundDown, false), half)
+}
+
+/*
+For internal use
+Calculates result of mathematical function and rounds it with RoundOption
+Function approx returns approximation with absolute error less then 10^-decimals.
+
+If approximation is too close to rounding boundary (see roundingBoundary), it is calculated again with more decimals,
+so rounding decision is stable. Approximation which stays at boundary is treated as exact result (e.g. pow(4, 1.5) = 8
+or acos(1) = 0), so directed rounding modes don't move exact results.
+*/
+func roundResult(ro roundOptionsType, approx func(decimals int) (*BigFloat, error)) (*BigFloat, error) {
+	for extra := guardDigits; ; extra *= 2 {
+		r, err := approx(ro.decimalPlaces + extra)
+		if err != nil {
+			return nil, err
+		}
+
+		b := roundingBoundary(r, ro)
+		tolerance := SetInt64(1).Div10(ro.decimalPlaces + extra - 1)
+		if New().Sub(r, b).CompareAbs(tolerance) >= 0 { // exact result is not at boundary
+			report(ro.conditions, r.roundCond(ro.decimalPlaces, ro.roundingMode, true))
+
+			return r.SetDecimals(ro.decimalPlaces), nil
+		}
+
+		if extra >= maxGuardDigits { // exact result
+			report(ro.conditions, b.roundCond(ro.decimalPlaces, ro.roundingMode, false))
+
+			return b.SetDecimals(ro.decimalPlaces), nil
+		}
+	}
+}
+
+/*
+For internal use
+Removes trailing zero decimals
+*/
+func (f *BigFloat) trimDecimals() *BigFloat {
+	if f.isCompact() { // coefficient has no trailing zeroes
+		return f
+	}
+
+	iTrim := 0
+	for i := 0; i < f.analysis.Decimals; i++ {
+		if f.analysis.Norm[f.analysis.Len-i-1] == '0' {
+			iTrim++
+		} else {
+			break
+		}
+	}
+
+	return f.SetDecimals(f.analysis.Decimals - iTrim)
+}
+
+/*
+Number of extra decimals used in internal calculations before final rounding
+*/
+const guardDigits = 5
+
+/*
+For internal use
+Returns if BigFloat number has no decimals (or all decimals are zeroes)
+*/
+func (f *BigFloat) isInt() bool {
+	if f.isCompact() { // coefficient has no trailing zeroes
+		return f.analysis.Exponent > 0
+	}
+
+	for i := f.analysis.Len - f.analysis.Decimals; i < f.analysis.Len; i++ {
+		if f.analysis.Norm[i] != '0' {
+			return false
+		}
+	}
+
+	return true
+}
+
+/*
+For internal use
+Returns integer part (truncated) of BigFloat number as int64
+If integer part doesn't fit int64 returns error
+*/
+func (f *BigFloat) int64Part() (int64, error) {
+	if f.isCompact() { // very small number or number with more than 1000 digits
+		if f.analysis.Exponent > 0 {
+			return 0, fmt.Errorf("ERROR: Integer part of number is out of int64 range")
+		}
+		return 0, nil
+	}
+
+	n, err := strconv.ParseInt(string(f.analysis.Norm[:f.analysis.Len-f.analysis.Decimals]), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("ERROR: Integer part of number is out of int64 range")
+	}
+
+	return int64(f.analysis.Sign) * n, nil
+}
+
+/*
+For internal use
+Rounds number to n decimals only if it has more than n decimals
+*/
+func (f *BigFloat) roundTo(n int) *BigFloat {
+	if f.decimals() > n {
+		f.Round(n)
+	}
+
+	return f
+}
+
+/*
+For internal use
+Multiplication rounded to n decimals
+*/
+func mulWP(a, b *BigFloat, n int) *BigFloat {
+	return New().Mul(a, b).roundTo(n)
+}
+
+/*
+For internal use
+Division rounded to n decimals
+Divisor must not be 0
+*/
+func divWP(a, b *BigFloat, n int) *BigFloat {
+	q := New()
+	q.Div(a, b, WithDivDecimalPlaces(n))
+
+	return q
+}
+
+/*
+For internal use
+Number of digits of non negative int64 number
+*/
+func digitsInt64(n int64) int {
+	return len(strconv.FormatInt(n, 10))
+}
+
+/*
+For internal use
+Returns if BigFloat number is exact power of 10 (e.g. 1000, 1, 0.01)
+*/
+func (f *BigFloat) isPow10() bool {
+	if f.analysis.Sign < 0 {
+		return false
+	}
+
+	found := false
+	for i := 0; i < f.analysis.Len; i++ {
+		if f.analysis.Norm[i] == '1' && !found {
+			found = true
+		} else if f.analysis.Norm[i] != '0' {
+			return false
+		}
+	}
+
+	return found
+}
+
+/*
+For internal use
+Copy of BigFloat number with its own digits (Copy shares digits with original number)
+*/
+func (f *BigFloat) clone() *BigFloat {
+	c := &BigFloat{f.analysis}
+	c.analysis.Norm = append([]byte(nil), f.analysis.Norm...)
+
+	return c
+}
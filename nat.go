@@ -0,0 +1,524 @@
+/*
+Copyright 2023 Tihomir Magdic. All rights reserved.
+Use of this source code is governed by a BSD-style
+license that can be found in the LICENSE file.
+*/
+
+package bigfloat
+
+import (
+	"math"
+	"stranalyzer"
+)
+
+/*
+For internal use
+Non negative integer as limbs in base 10^9 (least significant limb first, without leading zero limbs, 0 is empty)
+Used in integer algorithms with long chains of multiplications and divisions (e.g. modular exponentiation)
+Base is power of 10 so conversion from/to digits of BigFloat number is linear
+*/
+type nat []uint32
+
+const (
+	natBase   = 1000000000 // base of limbs
+	natDigits = 9          // decimal digits in one limb
+)
+
+/*
+For internal use
+Converts absolute value of integer part of BigFloat number to nat
+*/
+func toNat(a *BigFloat) nat {
+	a = a.expanded()
+	digits := a.analysis.Norm[:a.analysis.Len-a.analysis.Decimals]
+
+	z := make(nat, 0, len(digits)/natDigits+1)
+	for end := len(digits); end > 0; end -= natDigits { // 9 digits from the end into every limb
+		var w uint32
+		for _, d := range digits[maxInt(end-natDigits, 0):end] {
+			w = w*10 + uint32(d-'0')
+		}
+		z = append(z, w)
+	}
+
+	return z.norm()
+}
+
+/*
+For internal use
+Converts uint64 number to nat
+*/
+func natUint64(n uint64) nat {
+	z := nat{}
+	for ; n > 0; n /= natBase {
+		z = append(z, uint32(n%natBase))
+	}
+
+	return z
+}
+
+/*
+For internal use
+Converts nat to non negative BigFloat number without decimals
+*/
+func (z nat) bigFloat() *BigFloat {
+	if len(z) == 0 {
+		return New()
+	}
+
+	norm := make([]byte, 0, len(z)*natDigits)
+	var limb [natDigits]byte
+	for i := len(z) - 1; i >= 0; i-- {
+		w := z[i]
+		for j := natDigits - 1; j >= 0; j-- {
+			limb[j] = byte(w%10) + '0'
+			w /= 10
+		}
+		if i == len(z)-1 { // no leading zeroes in the most significant limb
+			j := 0
+			for limb[j] == '0' {
+				j++
+			}
+			norm = append(norm, limb[j:]...)
+		} else {
+			norm = append(norm, limb[:]...)
+		}
+	}
+
+	return &BigFloat{stranalyzer.Shift(stranalyzer.Analysis{Norm: norm, Len: len(norm), Sign: 1}, 0)} // compact form for many trailing zeroes
+}
+
+/*
+For internal use
+Removes leading zero limbs
+*/
+func (z nat) norm() nat {
+	i := len(z)
+	for i > 0 && z[i-1] == 0 {
+		i--
+	}
+
+	return z[:i]
+}
+
+/*
+For internal use
+Returns if nat is 1
+*/
+func (z nat) isOne() bool {
+	return len(z) == 1 && z[0] == 1
+}
+
+/*
+For internal use
+Returns if nat is odd number (base of limbs is even)
+*/
+func (z nat) isOdd() bool {
+	return len(z) > 0 && z[0]&1 == 1
+}
+
+/*
+For internal use
+Compares two nat numbers and returns -1, 0 or 1
+*/
+func natCmp(x, y nat) int {
+	if len(x) != len(y) {
+		if len(x) < len(y) {
+			return -1
+		}
+		return 1
+	}
+
+	for i := len(x) - 1; i >= 0; i-- {
+		if x[i] != y[i] {
+			if x[i] < y[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	return 0
+}
+
+/*
+For internal use
+Addition of two nat numbers
+*/
+func natAdd(x, y nat) nat {
+	if len(x) < len(y) {
+		x, y = y, x
+	}
+
+	z := make(nat, len(x)+1)
+	var carry uint32
+	for i := range x {
+		s := x[i] + carry
+		if i < len(y) {
+			s += y[i]
+		}
+		carry = 0
+		if s >= natBase {
+			s -= natBase
+			carry = 1
+		}
+		z[i] = s
+	}
+	z[len(x)] = carry
+
+	return z.norm()
+}
+
+/*
+For internal use
+Subtraction of two nat numbers
+Warning: x must be greater or equal to y
+*/
+func natSub(x, y nat) nat {
+	z := make(nat, len(x))
+	var borrow int64
+	for i := range x {
+		d := int64(x[i]) - borrow
+		if i < len(y) {
+			d -= int64(y[i])
+		}
+		borrow = 0
+		if d < 0 {
+			d += natBase
+			borrow = 1
+		}
+		z[i] = uint32(d)
+	}
+
+	return z.norm()
+}
+
+/*
+For internal use
+Multiplication of two nat numbers (long multiplication)
+*/
+func natMul(x, y nat) nat {
+	if len(x) == 0 || len(y) == 0 {
+		return nat{}
+	}
+
+	z := make(nat, len(x)+len(y))
+	for i, xi := range x {
+		if xi == 0 {
+			continue
+		}
+		var carry uint64
+		for j, yj := range y { // (10^9-1)^2 + 2*10^9 fits into uint64
+			t := uint64(z[i+j]) + uint64(xi)*uint64(yj) + carry
+			z[i+j] = uint32(t % natBase)
+			carry = t / natBase
+		}
+		z[i+len(y)] = uint32(carry)
+	}
+
+	return z.norm()
+}
+
+/*
+For internal use
+Multiplication of nat number and one limb
+*/
+func natMulWord(x nat, w uint32) nat {
+	z := make(nat, len(x)+1)
+	var carry uint64
+	for i, xi := range x {
+		t := uint64(xi)*uint64(w) + carry
+		z[i] = uint32(t % natBase)
+		carry = t / natBase
+	}
+	z[len(x)] = uint32(carry)
+
+	return z.norm()
+}
+
+/*
+For internal use
+Division of nat number with one non zero limb, returns quotient and remainder
+*/
+func natDivWord(x nat, w uint32) (nat, uint32) {
+	q := make(nat, len(x))
+	var r uint64
+	for i := len(x) - 1; i >= 0; i-- {
+		t := r*natBase + uint64(x[i])
+		q[i] = uint32(t / uint64(w))
+		r = t % uint64(w)
+	}
+
+	return q.norm(), uint32(r)
+}
+
+/*
+For internal use
+Division of nat numbers, returns quotient and remainder (Knuth's algorithm D)
+Panics for division by zero
+*/
+func natDivMod(u, v nat) (nat, nat) {
+	if len(v) == 0 {
+		panic("ERROR: Division by zero")
+	}
+
+	if natCmp(u, v) < 0 {
+		return nat{}, append(nat(nil), u...)
+	}
+
+	if len(v) == 1 {
+		q, r := natDivWord(u, v[0])
+
+		return q, natUint64(uint64(r))
+	}
+
+	n, m := len(v), len(u)-len(v)
+
+	d := uint32(natBase / (uint64(v[n-1]) + 1)) // normalization so that most significant limb of divisor is at least base / 2
+	vn := natMulWord(v, d)
+	un := make(nat, len(u)+1)
+	copy(un, natMulWord(u, d))
+
+	q := make(nat, m+1)
+	for j := m; j >= 0; j-- {
+		num := uint64(un[j+n])*natBase + uint64(un[j+n-1]) // estimate quotient limb from two leading limbs
+		qhat := num / uint64(vn[n-1])
+		rhat := num % uint64(vn[n-1])
+		for qhat >= natBase || qhat*uint64(vn[n-2]) > rhat*natBase+uint64(un[j+n-2]) {
+			qhat--
+			rhat += uint64(vn[n-1])
+			if rhat >= natBase {
+				break
+			}
+		}
+
+		var carry uint64 // multiply and subtract
+		var borrow int64
+		for i := 0; i < n; i++ {
+			p := qhat*uint64(vn[i]) + carry
+			carry = p / natBase
+			t := int64(un[i+j]) - int64(p%natBase) - borrow
+			borrow = 0
+			if t < 0 {
+				t += natBase
+				borrow = 1
+			}
+			un[i+j] = uint32(t)
+		}
+		t := int64(un[j+n]) - int64(carry) - borrow
+		borrow = 0
+		if t < 0 {
+			t += natBase
+			borrow = 1
+		}
+		un[j+n] = uint32(t)
+
+		if borrow != 0 { // estimate was one too large, add divisor back
+			qhat--
+			var c uint32
+			for i := 0; i < n; i++ {
+				s := un[i+j] + vn[i] + c
+				c = 0
+				if s >= natBase {
+					s -= natBase
+					c = 1
+				}
+				un[i+j] = s
+			}
+			un[j+n] = (un[j+n] + c) % natBase
+		}
+
+		q[j] = uint32(qhat)
+	}
+
+	r, _ := natDivWord(un[:n].norm(), d) // remove normalization from remainder
+
+	return q.norm(), r
+}
+
+/*
+For internal use
+Remainder of division of nat numbers
+*/
+func natMod(u, v nat) nat {
+	_, r := natDivMod(u, v)
+
+	return r
+}
+
+/*
+For internal use
+Calculates x^e mod m for x < m (left-to-right decimal digits of exponent)
+Every digit of exponent takes 4 multiplications for 10th power and one with precalculated x^digit
+*/
+func natModPow(x, e, m nat) nat {
+	if m.isOne() {
+		return nat{}
+	}
+
+	var powers [10]nat // x^0 ... x^9 mod m
+	powers[0] = nat{1}
+	for i := 1; i < 10; i++ {
+		powers[i] = natMod(natMul(powers[i-1], x), m)
+	}
+
+	result := nat{1}
+	var limb [natDigits]uint32
+	for i := len(e) - 1; i >= 0; i-- {
+		w := e[i]
+		for j := natDigits - 1; j >= 0; j-- {
+			limb[j] = w % 10
+			w /= 10
+		}
+		for _, digit := range limb {
+			if !result.isOne() { // result^10 (skips leading zeroes)
+				r2 := natMod(natMul(result, result), m)
+				r4 := natMod(natMul(r2, r2), m)
+				r5 := natMod(natMul(r4, result), m)
+				result = natMod(natMul(r5, r5), m)
+			}
+			if digit > 0 {
+				result = natMod(natMul(result, powers[digit]), m)
+			}
+		}
+	}
+
+	return result
+}
+
+/*
+For internal use
+Calculates modular inverse of a modulo m (extended Euclid's algorithm), returns false if inverse doesn't exist
+Coefficients of algorithm alternate in sign, so only their absolute values are calculated
+*/
+func natModInverse(a, m nat) (nat, bool) {
+	oldR, r := m, natMod(a, m)
+	oldT, t := nat{}, nat{1} // |t| of coefficients, sign of t is (-1)^(k+1) in step k
+	k := 1
+
+	for len(r) > 0 {
+		q, rem := natDivMod(oldR, r)
+		oldR, r = r, rem
+		oldT, t = t, natAdd(oldT, natMul(q, t))
+		k++
+	}
+
+	if !oldR.isOne() {
+		return nil, false
+	}
+
+	if k%2 == 1 && len(oldT) > 0 { // negative coefficient
+		oldT = natSub(m, natMod(oldT, m))
+	}
+
+	return natMod(oldT, m), true
+}
+
+/*
+For internal use
+Calculates x^n (square-and-multiply)
+*/
+func natPow(x nat, n uint64) nat {
+	result := nat{1}
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			result = natMul(result, x)
+		}
+		if n > 1 {
+			x = natMul(x, x)
+		}
+	}
+
+	return result
+}
+
+/*
+For internal use
+Estimate of n-th root of non zero nat number with about 10 correct digits (calculated with float64 logarithm)
+*/
+func natRootEstimate(a nat, n int) nat {
+	lead, exp10 := float64(a[len(a)-1]), natDigits*(len(a)-1) // a is about lead * 10^exp10
+	if len(a) > 1 {
+		lead = lead*natBase + float64(a[len(a)-2])
+		exp10 -= natDigits
+	}
+
+	e := (math.Log10(lead) + float64(exp10)) / float64(n) // root is 10^e
+	whole := math.Floor(e)
+	mantissa := math.Pow(10, e-whole) // 1 <= mantissa < 10
+	if whole < 15 {
+		return natUint64(uint64(mantissa*math.Pow(10, whole)) + 1)
+	}
+
+	return natMul(natUint64(uint64(mantissa*1e15)), natPow(nat{10}, uint64(whole)-15))
+}
+
+/*
+For internal use
+Integer n-th root (floor) of nat number with Newton's method x = ((n - 1) * x + a / x^(n - 1)) / n
+Initial value is close estimate, so only a few steps are needed (every step doubles number of correct digits)
+*/
+func natRoot(a nat, n int) nat {
+	if len(a) == 0 || n == 1 {
+		return a
+	}
+
+	nm1, nn := natUint64(uint64(n-1)), natUint64(uint64(n))
+	x := natRootEstimate(a, n)
+	for i := 0; ; i++ {
+		q, _ := natDivMod(a, natPow(x, uint64(n-1)))
+		y, _ := natDivMod(natAdd(natMul(x, nm1), q), nn)
+		if i > 0 && natCmp(y, x) >= 0 { // every step after the first one is not below root (inequality of arithmetic and geometric means)
+			return x
+		}
+		x = y
+	}
+}
+
+/*
+For internal use
+Integer square root of nat number (Newton's method)
+*/
+func natSqrt(n nat) nat {
+	if len(n) == 0 {
+		return nat{}
+	}
+
+	x := make(nat, (len(n)+1)/2+1) // initial value greater than square root
+	x[len(x)-1] = 1
+	for {
+		q, _ := natDivMod(n, x)
+		y, _ := natDivWord(natAdd(x, q), 2)
+		if natCmp(y, x) >= 0 {
+			return x
+		}
+		x = y
+	}
+}
+
+/*
+For internal use
+Returns binary digits of nat number (most significant first)
+*/
+func (z nat) bits() []byte {
+	const chunk = 1 << 29 // power of 2 which fits into limb
+
+	bits := make([]byte, 0, len(z)*30)
+	for x := z; len(x) > 0; {
+		var r uint32
+		x, r = natDivWord(x, chunk)
+		for i := 0; i < 29; i++ {
+			bits = append(bits, byte(r&1))
+			r >>= 1
+		}
+	}
+
+	for len(bits) > 0 && bits[len(bits)-1] == 0 { // remove leading zeroes
+		bits = bits[:len(bits)-1]
+	}
+	for i, j := 0, len(bits)-1; i < j; i, j = i+1, j-1 {
+		bits[i], bits[j] = bits[j], bits[i]
+	}
+
+	return bits
+}
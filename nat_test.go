@@ -0,0 +1,58 @@
+package bigfloat
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestNat(t *testing.T) {
+	var cases = []struct {
+		param1 string
+		param2 string
+	}{
+		{"0", "7"},
+		{"6", "7"},
+		{"1000000000", "1"},
+		{"999999999999999999", "1000000000"},
+		{"1000000000000000000000000000", "999999999000000001"},
+		{"123456789012345678901234567890", "987654321"},
+		{"340282366920938463463374607431768211455", "18446744073709551615"},
+		{"4999999999999999999999999999", "500000000000000001"},
+		{"1" + strings.Repeat("0", 100), "1" + strings.Repeat("0", 50) + "1"},
+		{strings.Repeat("9", 90), strings.Repeat("9", 45)},
+	}
+
+	random := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ { // random operands with different lengths
+		digits := func(n int) string {
+			var b strings.Builder
+			b.WriteByte(byte('1' + random.Intn(9)))
+			for j := 1; j < n; j++ {
+				b.WriteByte(byte('0' + random.Intn(10)))
+			}
+			return b.String()
+		}
+		cases = append(cases, struct {
+			param1 string
+			param2 string
+		}{digits(1 + random.Intn(80)), digits(1 + random.Intn(40))})
+	}
+
+	fmt.Printf("\nTestNat...\n")
+	for _, c := range cases {
+		n1, n2, err := create2BigFloats(t, c.param1, c.param2)
+		if err != nil {
+			continue
+		}
+
+		x, y := toNat(n1), toNat(n2)
+		q, r := natDivMod(x, y)
+		expectedQ, expectedR, err := New().DivMod(n1, n2)
+
+		result := fmt.Sprintf("%v, %v, %v, %v, %v", x.bigFloat(), natMul(x, y).bigFloat(), q.bigFloat(), r.bigFloat(), natAdd(natSub(x, r), r).bigFloat())
+		printResult(t, result, fmt.Sprintf("%v, %v, %v, %v, %v", n1, New().Mul(n1, n2), expectedQ, expectedR, n1), err)
+	}
+	fmt.Printf("%d cases\n", len(cases))
+}
@@ -0,0 +1,283 @@
+/*
+Copyright 2023 Tihomir Magdic. All rights reserved.
+Use of this source code is governed by a BSD-style
+license that can be found in the LICENSE file.
+*/
+
+package bigfloat
+
+import (
+	"fmt"
+)
+
+/*
+Function type for power operation.
+
+See: PowInt, Pow
+*/
+type PowOption func(*powOptionsType)
+
+type powOptionsType struct {
+	decimalPlaces    int
+	maxDecimalPlaces int
+	maxDigits        int
+	roundingMode     RoundingMode
+	scale            ScalePolicy
+}
+
+/*
+Function defines precision in power operation.
+
+For negative exponents recommended to use repeating decimals.
+*/
+func WithPowDecimalPlaces(decimalPlaces int) PowOption {
+	return func(po *powOptionsType) {
+		po.decimalPlaces = decimalPlaces
+	}
+}
+
+/*
+Function defines maximum decimals in division for negative exponents
+Effective with very long decimals
+*/
+func WithPowMaxDecimalPlaces(maxDecimalPlaces int) PowOption {
+	return func(po *powOptionsType) {
+		po.maxDecimalPlaces = maxDecimalPlaces
+	}
+}
+
+/*
+Function defines maximum number of digits (whole number and decimals) of power
+Effective with large exponents - operation is stopped with error when limit is exceeded
+*/
+func WithPowMaxDigits(maxDigits int) PowOption {
+	return func(po *powOptionsType) {
+		po.maxDigits = maxDigits
+	}
+}
+
+/*
+Function defines rounding mode of last decimal in power operation
+Effective only with target decimal places
+*/
+func WithPowRoundingMode(mode RoundingMode) PowOption {
+	return func(po *powOptionsType) {
+		po.roundingMode = mode
+	}
+}
+
+/*
+Calculates integer power of BigFloat number with PowOption:
+
+	decimalPlaces - target decimal places (default is -1 for exact result or detecting repeating decimals for negative exponents)
+	maxDecimalPlaces - safety parameter for division with a very large number of decimal places for negative exponents (default is 1e4 - 10000)
+	maxDigits - safety parameter for the number of digits of power (default is 0 - unlimited)
+	roundingMode - rounding of last decimal with target decimal places (default is RoundHalfUp)
+	scale - scale policy of every multiplication (default is ScaleUnbounded - exact power)
+
+Uses square-and-multiply algorithm. Negative exponents are calculated as division 1 / a^-n so number of repeating decimals is returned like in Div.
+*/
+func (f *BigFloat) PowInt(a *BigFloat, n int64, options ...PowOption) (*BigFloat, int, error) {
+	po := powOptionsType{ // default option values
+		decimalPlaces:    -1,
+		maxDecimalPlaces: int(1e4),
+		maxDigits:        0,
+	}
+
+	for _, option := range options { // process variadic arguments
+		option(&po)
+	}
+
+	if !po.scale.valid() {
+		return nil, 0, fmt.Errorf("ERROR: Invalid scale policy")
+	}
+
+	if err := checkFinite(a); err != nil {
+		return nil, 0, err
+	}
+
+	negative := n < 0
+	exp := uint64(n)
+	if negative {
+		exp = uint64(-n) // works for math.MinInt64 too
+	}
+
+	result := SetInt64(1)
+	base := a.Copy()
+
+	for exp > 0 { // square-and-multiply
+		if exp&1 == 1 {
+			result = New().Mul(result, base, WithMulScale(po.scale))
+			if po.maxDigits > 0 && result.digits() > po.maxDigits {
+				return nil, 0, fmt.Errorf("ERROR: Power exceeds maximum number of digits (%d)", po.maxDigits)
+			}
+		}
+		exp >>= 1
+		if exp > 0 {
+			base = New().Mul(base, base, WithMulScale(po.scale))
+			if po.maxDigits > 0 && base.digits() > po.maxDigits {
+				return nil, 0, fmt.Errorf("ERROR: Power exceeds maximum number of digits (%d)", po.maxDigits)
+			}
+		}
+	}
+
+	if negative { // a^-n = 1 / a^n
+		if result.IsInt64(0) {
+			return nil, 0, fmt.Errorf("ERROR: Division by zero")
+		}
+
+		return f.Div(SetInt64(1), result, WithDivDecimalPlaces(po.decimalPlaces), WithDivMaxDecimalPlaces(po.maxDecimalPlaces), WithDivRoundingMode(po.roundingMode))
+	}
+
+	f.analysis = result.analysis
+
+	if po.decimalPlaces >= 0 {
+		f.round(po.decimalPlaces, po.roundingMode, false)
+		f.SetDecimals(po.decimalPlaces)
+	}
+
+	return f, 0, nil
+}
+
+/*
+Calculates x^y with PowOption:
+
+	decimalPlaces - target decimal places of result (required when result is not exact)
+	maxDecimalPlaces - safety parameter for division with negative integer exponents (default is 1e4 - 10000)
+	maxDigits - maximum number of digits of power (default is 1e6)
+	roundingMode - rounding mode of result (default is RoundHalfUp)
+	scale - scale policy of power (default is ScaleUnbounded)
+
+ScaleKeepMax rounds result with rounding mode of policy to decimal places of base and ScaleCap
+rounds result with more decimal places than its limit, so decimal places are not required with these policies.
+
+For integer exponent result is exact (if it has finite number of decimals). For x^0.5 square root is calculated.
+With decimalPlaces result always has decimalPlaces decimal places, so exact result is rounded or padded with zeroes
+(e.g. 4.00^0.5 with 6 decimal places is 2.000000 and 1.05^5 with 2 decimal places is 1.28).
+Without decimalPlaces exact power of integer exponent keeps its own decimal places (limited by scale policy).
+Otherwise x^y = e^(y * ln(x)) is calculated in decimal arithmetic and rounded to target decimal places.
+Negative base with non-integer exponent returns error.
+*/
+func (f *BigFloat) Pow(x, y *BigFloat, options ...PowOption) (*BigFloat, error) {
+	po := powOptionsType{ // default option values
+		decimalPlaces:    -1, // no default precision
+		maxDecimalPlaces: int(1e4),
+		maxDigits:        int(maxExpDigits),
+	}
+	for _, option := range options {
+		option(&po)
+	}
+
+	if !po.roundingMode.valid() {
+		return nil, fmt.Errorf("ERROR: Invalid rounding mode")
+	} else if po.maxDigits < 0 {
+		return nil, fmt.Errorf("ERROR: Negative digit limit")
+	} else if !po.scale.valid() {
+		return nil, fmt.Errorf("ERROR: Invalid scale policy")
+	}
+
+	if err := checkFinite(x, y); err != nil {
+		return nil, err
+	}
+
+	fixed := po.decimalPlaces >= 0 // result has target decimal places even if it is exact
+
+	switch po.scale.kind { // decimal places of inexact power are defined by scale policy
+	case scaleKeepMax:
+		po.decimalPlaces, po.roundingMode = x.decimals(), po.scale.mode
+	case scaleCap:
+		if po.decimalPlaces < 0 || po.decimalPlaces > po.scale.decimals {
+			po.decimalPlaces, po.roundingMode = po.scale.decimals, po.scale.mode
+		}
+	}
+
+	if y.isInt() { // integer exponent
+		n, err := y.int64Part()
+		if err != nil {
+			return nil, err
+		}
+
+		r := New()
+		_, repDec, err := r.PowInt(x, n, WithPowMaxDecimalPlaces(po.maxDecimalPlaces), WithPowMaxDigits(po.maxDigits), WithPowScale(po.scale))
+		if err != nil {
+			return nil, err
+		}
+
+		if repDec > 0 || r.decimals() >= po.maxDecimalPlaces { // infinite number of decimals
+			if po.decimalPlaces < 0 {
+				return nil, fmt.Errorf("ERROR: Decimal places are required for inexact power")
+			}
+			_, _, err = r.PowInt(x, n, WithPowDecimalPlaces(po.decimalPlaces), WithPowMaxDecimalPlaces(po.maxDecimalPlaces), WithPowRoundingMode(po.roundingMode), WithPowMaxDigits(po.maxDigits), WithPowScale(po.scale))
+			if err != nil {
+				return nil, err
+			}
+		}
+		if fixed {
+			r.round(po.decimalPlaces, po.roundingMode, false).SetDecimals(po.decimalPlaces)
+		} else {
+			po.scale.apply(r, x.decimals()) // quotient of negative exponent
+		}
+		f.analysis = r.analysis
+
+		return f, nil
+	}
+
+	if x.GetSign() < 0 && !x.IsInt64(0) {
+		return nil, fmt.Errorf("ERROR: Negative base with non-integer exponent")
+	}
+
+	if po.decimalPlaces < 0 {
+		return nil, fmt.Errorf("ERROR: Decimal places are required for non-integer exponent")
+	}
+
+	if x.IsInt64(0) { // 0^y
+		if y.GetSign() < 0 {
+			return nil, fmt.Errorf("ERROR: Division by zero")
+		}
+		f.SetInt64(0).SetDecimals(po.decimalPlaces)
+
+		return f, nil
+	}
+
+	half, _ := SetString("0.5")
+	if y.Compare(half) == 0 { // x^0.5 = sqrt(x)
+		if _, _, err := f.Sqrt(x, WithDecimalPlaces(po.decimalPlaces), WithRoundingMode(po.roundingMode)); err != nil {
+			return nil, err
+		}
+
+		return f.SetDecimals(po.decimalPlaces), nil // exact root has target decimal places too
+	}
+
+	estimate := New().Mul(y, ln(x, 2)) // rough y * ln(x) for number of whole number digits of result
+	n, err := estimate.int64Part()
+	if err != nil || n > maxExpDigits*10000/4343 {
+		return nil, fmt.Errorf("ERROR: Power is too large")
+	}
+	resultDigits := 1
+	if n > 0 {
+		resultDigits += int(n * 4343 / 10000)
+	}
+	if po.maxDigits > 0 && resultDigits+po.decimalPlaces > po.maxDigits {
+		return nil, fmt.Errorf("ERROR: Power exceeds maximum number of digits (%d)", po.maxDigits)
+	}
+
+	yInt, err := y.int64Part()
+	if err != nil {
+		return nil, err
+	}
+
+	ro := roundOptionsType{decimalPlaces: po.decimalPlaces, roundingMode: po.roundingMode}
+	r, err := roundResult(ro, func(decimals int) (*BigFloat, error) {
+		wp := decimals + resultDigits + digitsInt64(yInt*int64(y.GetSign())) // error of ln(x) is multiplied by y and e^(y * ln(x))
+		t := mulWP(y, ln(x, wp), wp)
+
+		return exp(t, decimals)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	f.analysis = r.analysis
+
+	return f, nil
+}
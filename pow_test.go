@@ -0,0 +1,249 @@
+package bigfloat
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestPowInt(t *testing.T) {
+	var cases = []struct {
+		param1   string
+		param2   int64
+		decimals int
+		expected string
+	}{
+		{"2", 0, -1, "1"},
+		{"0", 0, -1, "1"},
+		{"0", 5, -1, "0"},
+		{"2", 1, -1, "2"},
+		{"2", 10, -1, "1024"},
+		{"-2", 3, -1, "-8"},
+		{"-2", 4, -1, "16"},
+		{"1.5", 3, -1, "3.375"},
+		{"1.0375", 2, -1, "1.07640625"},
+		{"-0.1", 5, -1, "-0.00001"},
+		{"3", -2, -1, "0.(1)"},
+		{"2", -3, -1, "0.125"},
+		{"7", -1, -1, "0.(142857)"},
+		{"-3", -3, -1, "-0.(037)"},
+		{"0.1", -3, -1, "1000"},
+		{"1.5", 3, 1, "3.4"},
+		{"2", -3, 2, "0.13"},
+		{"3", -2, 5, "0.11111"},
+		{"2", 3, 2, "8.00"},
+		{"99", 9, -1, "913517247483640899"},
+		{"2", 64, -1, "18446744073709551616"},
+	}
+	fmt.Printf("\nTestPowInt...\n")
+	for _, c := range cases {
+		fmt.Printf("powInt(%v, %v, %v) = ", c.param1, c.param2, c.decimals)
+		n1, err := createBigFloat(t, c.param1)
+		if err != nil {
+			continue
+		}
+
+		n2 := New()
+		_, repDec, err := n2.PowInt(n1, c.param2, WithPowDecimalPlaces(c.decimals))
+		if err != nil {
+			fmt.Printf("%v\n", err)
+			t.Errorf("Power error %v", err)
+			continue
+		}
+
+		expectedStr := c.expected
+		result := n2.StringF(repDec)
+
+		fmt.Printf("%v\n", result)
+		printResult(t, result, expectedStr, err)
+	}
+}
+
+func TestErrorsPowInt(t *testing.T) {
+	var cases = []struct {
+		param1    string
+		param2    int64
+		maxDigits int
+	}{
+		{"0", -1, 0},
+		{"0.00", -2, 0},
+		{"2", 100, 10},
+		{"1.0375", 50, 100},
+	}
+
+	fmt.Printf("\nTestErrorsPowInt...\n")
+	for _, c := range cases {
+		fmt.Printf("powInt(%v, %v, %v) = ", c.param1, c.param2, c.maxDigits)
+		n1, err := createBigFloat(t, c.param1)
+		if err != nil {
+			continue
+		}
+		func() {
+			defer func() {
+				if err := recover(); err != nil {
+					fmt.Printf("\nOK: panic occurred: %v\n", err)
+				}
+			}()
+
+			n2 := New()
+			_, _, err := n2.PowInt(n1, c.param2, WithPowMaxDigits(c.maxDigits))
+			if err != nil {
+				panic(err)
+			}
+
+			errorStr := fmt.Sprintf("%v should raise panic", c)
+			fmt.Printf("\n" + errorStr + "\n")
+			t.Errorf(errorStr)
+		}()
+	}
+}
+
+func TestPow(t *testing.T) {
+	var cases = []struct {
+		param1   string
+		param2   string
+		decimals int
+		expected string
+	}{
+		{"2", "10", -1, "1024"},
+		{"2", "10.000", -1, "1024"},
+		{"1.5", "3", 2, "3.38"},
+		{"1.5", "3", -1, "3.375"},
+		{"1.05", "5", 2, "1.28"},
+		{"2", "-3", -1, "0.125"},
+		{"3", "-2", 5, "0.11111"},
+		{"-2", "3", -1, "-8"},
+		{"0", "2.5", 3, "0.000"},
+		{"2.25", "0.5", 10, "1.5000000000"},
+		{"4.00", "0.5", 6, "2.000000"},
+		{"4", "2", 3, "16.000"},
+		{"2", "-2", 4, "0.2500"},
+		{"2", "0.5", 20, "1.41421356237309504880"},
+		{"1.0375", "1.0375", 20, "1.03893328275478617018"},
+		{"2", "1.5", 15, "2.828427124746190"},
+		{"10", "0.3", 10, "1.9952623150"},
+		{"0.5", "2.5", 12, "0.176776695297"},
+		{"100", "-0.25", 20, "0.31622776601683793320"},
+		{"1.05", "30.5", 10, "4.4286730731"},
+		{"123.456", "7.89", 5, "31771028258180977.30907"},
+		{"0.001", "0.333", 18, "0.100230523807789967"},
+		{"2", "100.5", 10, "1792728671193156477399422023278.6614963942"},
+	}
+	fmt.Printf("\nTestPow...\n")
+	for _, c := range cases {
+		fmt.Printf("pow(%v, %v, %v) = ", c.param1, c.param2, c.decimals)
+		n1, n2, err := create2BigFloats(t, c.param1, c.param2)
+		if err != nil {
+			continue
+		}
+
+		n3 := New()
+		_, err = n3.Pow(n1, n2, WithPowDecimalPlaces(c.decimals))
+		if err != nil {
+			fmt.Printf("%v\n", err)
+			t.Errorf("Power error %v", err)
+			continue
+		}
+
+		expectedStr := c.expected
+		result := n3.String()
+
+		fmt.Printf("%v\n", result)
+		printResult(t, result, expectedStr, err)
+	}
+}
+
+func TestPowMaxDigits(t *testing.T) {
+	var cases = []struct {
+		param1    string
+		param2    string
+		maxDigits int
+		expected  string
+	}{
+		{"2", "30", 10, "1073741824.00"},
+		{"2", "34", 10, "ERROR: Power exceeds maximum number of digits (10)"},
+		{"0.5", "40", 10, "ERROR: Power exceeds maximum number of digits (10)"},
+		{"2", "100.5", 10, "ERROR: Power exceeds maximum number of digits (10)"},
+		{"1", "1000000000000", 10, "1.00"},
+		{"10", "1000000000000", -1, "ERROR: Power exceeds maximum number of digits (1000000)"}, // default limit
+		{"1.5", "2", 0, "2.25"},
+		{"2", "3", -5, "ERROR: Negative digit limit"},
+	}
+	fmt.Printf("\nTestPowMaxDigits...\n")
+	for _, c := range cases {
+		fmt.Printf("pow(%v, %v, maxDigits %v) = ", c.param1, c.param2, c.maxDigits)
+		n1, n2, err := create2BigFloats(t, c.param1, c.param2)
+		if err != nil {
+			continue
+		}
+
+		options := []PowOption{WithPowDecimalPlaces(2)}
+		if c.maxDigits != -1 {
+			options = append(options, WithPowMaxDigits(c.maxDigits))
+		}
+
+		n3 := New()
+		result := ""
+		allocated := allocatedBytes(func() {
+			if _, err := n3.Pow(n1, n2, options...); err != nil {
+				result = err.Error()
+			} else {
+				result = n3.String()
+			}
+		})
+		limit := c.maxDigits
+		if limit == -1 {
+			limit = int(maxExpDigits) // default limit
+		}
+		if limit > 0 && allocated > 50*uint64(limit)+1e6 { // power is stopped at digit limit, so allocation is proportional to the limit
+			t.Errorf("pow(%v, %v) allocated %v bytes", c.param1, c.param2, allocated)
+		}
+
+		fmt.Printf("%v\n", result)
+		printResult(t, result, c.expected, nil)
+	}
+}
+
+func TestErrorsPow(t *testing.T) {
+	var cases = []struct {
+		param1   string
+		param2   string
+		decimals int
+	}{
+		{"-2", "0.5", 10},
+		{"-8", "0.333", 10},
+		{"0", "-0.5", 10},
+		{"0", "-1", 10},
+		{"2", "0.5", -1},
+		{"3", "-1", -1},
+		{"10", "10000000.5", 2},
+		{"2", "1e1001", 2},
+		{"2", "-1e1001", 2},
+		{"1.5", "1e1001", 2},
+	}
+
+	fmt.Printf("\nTestErrorsPow...\n")
+	for _, c := range cases {
+		fmt.Printf("pow(%v, %v, %v) = ", c.param1, c.param2, c.decimals)
+		n1, n2, err := create2BigFloats(t, c.param1, c.param2)
+		if err != nil {
+			continue
+		}
+		func() {
+			defer func() {
+				if err := recover(); err != nil {
+					fmt.Printf("\nOK: panic occurred: %v\n", err)
+				}
+			}()
+
+			n3 := New()
+			_, err := n3.Pow(n1, n2, WithPowDecimalPlaces(c.decimals))
+			if err != nil {
+				panic(err)
+			}
+
+			errorStr := fmt.Sprintf("%v should raise panic", c)
+			fmt.Printf("\n" + errorStr + "\n")
+			t.Errorf(errorStr)
+		}()
+	}
+}
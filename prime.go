@@ -0,0 +1,275 @@
+/*
+Copyright 2023 Tihomir Magdic. All rights reserved.
+Use of this source code is governed by a BSD-style
+license that can be found in the LICENSE file.
+*/
+
+package bigfloat
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+/*
+Function type for primality test.
+
+See: ProbablyPrime
+*/
+type PrimeOption func(*primeOptionsType)
+
+type primeOptionsType struct {
+	lucas bool
+}
+
+/*
+Function defines if strong Lucas test is used after Miller-Rabin test with base 2 (Baillie-PSW test)
+There is no known composite number which passes Baillie-PSW test
+*/
+func WithLucas(lucas bool) PrimeOption {
+	return func(po *primeOptionsType) {
+		po.lucas = lucas
+	}
+}
+
+/*
+Bases of Miller-Rabin test which give exact result for all numbers below 2^64
+Numbers are also checked with trial division by these primes
+*/
+var primeBases = []uint32{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37}
+
+/*
+Reports if integer BigFloat number is prime with PrimeOption:
+
+	lucas - strong Lucas test after Miller-Rabin test with base 2 (default is false)
+
+Numbers below 2^64 are tested with deterministic Miller-Rabin bases, so result is exact.
+Larger numbers are tested with base 2 and rounds of pseudo random bases (every round has error probability below 1/4 for composite numbers).
+Prime number always passes the test. Negative numbers, 0 and 1 are not prime.
+Returns error for non integer numbers and negative rounds
+*/
+func (f *BigFloat) ProbablyPrime(rounds int, options ...PrimeOption) (bool, error) {
+	if err := checkInt(f); err != nil {
+		return false, err
+	}
+
+	if rounds < 0 {
+		return false, fmt.Errorf("ERROR: Negative number of rounds")
+	}
+
+	po := primeOptionsType{}
+	for _, option := range options { // process variadic arguments
+		option(&po)
+	}
+
+	if f.analysis.Sign < 0 {
+		return false, nil
+	}
+
+	return natProbablyPrime(toNat(f), rounds, po.lucas), nil
+}
+
+/*
+For internal use
+Primality test of nat number (see ProbablyPrime)
+*/
+func natProbablyPrime(n nat, rounds int, lucas bool) bool {
+	if len(n) == 0 || n.isOne() {
+		return false
+	}
+
+	for _, p := range primeBases { // trial division
+		if _, r := natDivWord(n, p); r == 0 {
+			return len(n) == 1 && n[0] == p
+		}
+	}
+	if natCmp(n, nat{primeBases[len(primeBases)-1] * primeBases[len(primeBases)-1]}) < 0 { // no prime factor up to sqrt(n)
+		return true
+	}
+
+	if natCmp(n, natUint64(math.MaxUint64)) <= 0 { // deterministic bases for numbers below 2^64
+		for _, p := range primeBases {
+			if !natMillerRabin(n, nat{p}) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	if !natMillerRabin(n, nat{2}) {
+		return false
+	}
+
+	random := rand.New(rand.NewSource(int64(n[0]))) // pseudo random bases in range [2, n-3]
+	nm4 := natSub(n, nat{4})
+	for i := 0; i < rounds; i++ {
+		x := make(nat, len(n))
+		for j := range x {
+			x[j] = uint32(random.Intn(natBase))
+		}
+		if !natMillerRabin(n, natAdd(natMod(x.norm(), nm4), nat{2})) {
+			return false
+		}
+	}
+
+	return !lucas || natStrongLucas(n)
+}
+
+/*
+For internal use
+Miller-Rabin test of odd number n > 2 with base a (n - 1 = d * 2^s, a^d = 1 or a^(d * 2^r) = n - 1 for some r < s)
+*/
+func natMillerRabin(n, a nat) bool {
+	nm1 := natSub(n, nat{1})
+	d, s := nm1, 0
+	for !d.isOdd() {
+		d, _ = natDivWord(d, 2)
+		s++
+	}
+
+	x := natModPow(natMod(a, n), d, n)
+	if x.isOne() || natCmp(x, nm1) == 0 {
+		return true
+	}
+	for r := 1; r < s; r++ {
+		x = natMod(natMul(x, x), n)
+		if natCmp(x, nm1) == 0 {
+			return true
+		} else if x.isOne() { // non trivial square root of 1
+			return false
+		}
+	}
+
+	return false
+}
+
+/*
+For internal use
+Jacobi symbol (d/n) of small odd number d (positive or negative) and odd number n
+*/
+func jacobi(d int64, n nat) int {
+	j := 1
+	if d < 0 { // (-1/n) = 1 for n = 1 mod 4, otherwise -1
+		d = -d
+		if n[0]%4 == 3 {
+			j = -j
+		}
+	}
+
+	_, r := natDivWord(n, uint32(d))
+	a, m := uint64(r), uint64(d) // (d/n) = (n mod d / d) with sign by quadratic reciprocity
+	if d%4 == 3 && n[0]%4 == 3 {
+		j = -j
+	}
+
+	for a != 0 { // Jacobi symbol of small numbers
+		for a%2 == 0 {
+			a /= 2
+			if m%8 == 3 || m%8 == 5 {
+				j = -j
+			}
+		}
+		a, m = m, a
+		if a%4 == 3 && m%4 == 3 {
+			j = -j
+		}
+		a %= m
+	}
+
+	if m != 1 {
+		return 0
+	}
+
+	return j
+}
+
+/*
+For internal use
+Returns nat number halved modulo odd n (x + n is even for odd x)
+*/
+func natHalfMod(x, n nat) nat {
+	if x.isOdd() {
+		x = natAdd(x, n)
+	}
+	x, _ = natDivWord(x, 2)
+
+	return x
+}
+
+/*
+For internal use
+Strong Lucas test of odd number n which is not divisible by small primes
+Parameters are chosen with Selfridge's method: first D in 5, -7, 9, -11, ... with Jacobi symbol (D/n) = -1, P = 1 and Q = (1 - D) / 4
+*/
+func natStrongLucas(n nat) bool {
+	d := int64(5)
+	for tries := 0; ; tries++ {
+		j := jacobi(d, n)
+		if j == -1 {
+			break
+		} else if j == 0 { // n has common factor with D (n is greater than D)
+			return false
+		}
+		if tries == 20 { // perfect square has no D with (D/n) = -1
+			if root := natSqrt(n); natCmp(natMul(root, root), n) == 0 {
+				return false
+			}
+		}
+		if d > 0 {
+			d = -d - 2
+		} else {
+			d = -d + 2
+		}
+	}
+
+	small := func(v int64) nat { // small number modulo n
+		if v >= 0 {
+			return natMod(natUint64(uint64(v)), n)
+		}
+		return natSub(n, natMod(natUint64(uint64(-v)), n))
+	}
+	dm, qm := small(d), small((1-d)/4)
+	twice := func(x nat) nat { // 2 * x modulo n
+		return natMod(natAdd(x, x), n)
+	}
+	sub := func(x, y nat) nat { // x - y modulo n
+		if natCmp(x, y) >= 0 {
+			return natSub(x, y)
+		}
+		return natSub(natAdd(x, n), y)
+	}
+
+	np1 := natAdd(n, nat{1}) // n + 1 = k * 2^s
+	k, s := np1, 0
+	for !k.isOdd() {
+		k, _ = natDivWord(k, 2)
+		s++
+	}
+
+	u, v, qk := nat{1}, nat{1}, qm // U_1 = 1, V_1 = P, Q^1
+	bits := k.bits()
+	for _, bit := range bits[1:] { // U_2k = U_k * V_k, V_2k = V_k^2 - 2 * Q^k
+		u = natMod(natMul(u, v), n)
+		v = sub(natMod(natMul(v, v), n), twice(qk))
+		qk = natMod(natMul(qk, qk), n)
+		if bit == 1 { // U_k+1 = (P * U_k + V_k) / 2, V_k+1 = (D * U_k + P * V_k) / 2
+			u, v = natHalfMod(natMod(natAdd(u, v), n), n), natHalfMod(natMod(natAdd(natMul(dm, u), v), n), n)
+			qk = natMod(natMul(qk, qm), n)
+		}
+	}
+
+	if len(u) == 0 || len(v) == 0 {
+		return true
+	}
+	for r := 1; r < s; r++ { // V_2k = V_k^2 - 2 * Q^k
+		v = sub(natMod(natMul(v, v), n), twice(qk))
+		if len(v) == 0 {
+			return true
+		}
+		qk = natMod(natMul(qk, qk), n)
+	}
+
+	return false
+}
@@ -0,0 +1,111 @@
+package bigfloat
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestProbablyPrime(t *testing.T) {
+	var cases = []struct {
+		param    string
+		expected bool
+	}{
+		{"-7", false},
+		{"0", false},
+		{"1", false},
+		{"2", true},
+		{"3", true},
+		{"4", false},
+		{"37", true},
+		{"1369", false},
+		{"1361", true},
+		{"561", false},                 // Carmichael number
+		{"41041", false},               // Carmichael number
+		{"3215031751", false},          // strong pseudoprime to bases 2, 3, 5 and 7
+		{"3825123056546413051", false}, // strong pseudoprime to bases 2 to 23
+		{"2305843009213693951", true},  // 2^61 - 1
+		{"18446744073709551557", true}, // largest prime below 2^64
+		{"18446744073709551629", true}, // smallest prime above 2^64
+		{"1000000014000000049", false}, // square of prime
+		{"17.000", true},
+		{"618970019642690137449562111", true}, // 2^89 - 1
+		{"170141183460469231731687303715884105727", true}, // 2^127 - 1
+		{"162259276829213363391578010288127", true},       // 2^107 - 1
+		{"1427247692705959880439315947500961989719490561", false},
+		{"1506334550815795554361", false}, // strong pseudoprime to base 2
+	}
+	fmt.Printf("\nTestProbablyPrime...\n")
+	for _, c := range cases {
+		fmt.Printf("probablyPrime(%v) = ", c.param)
+		n, err := createBigFloat(t, c.param)
+		if err != nil {
+			continue
+		}
+
+		prime, err := n.ProbablyPrime(10)
+		primeLucas, errLucas := n.ProbablyPrime(0, WithLucas(true))
+		if errLucas != nil {
+			err = errLucas
+		}
+
+		result := fmt.Sprintf("%v, %v", prime, primeLucas)
+		fmt.Printf("%v\n", result)
+		printResult(t, result, fmt.Sprintf("%v, %v", c.expected, c.expected), err)
+	}
+}
+
+func TestProbablyPrimeLucas(t *testing.T) {
+	fmt.Printf("\nTestProbablyPrimeLucas...\n")
+	n, _ := SetString("1506334550815795554361") // strong pseudoprime to base 2 (6308461 * 12616921 * 18925381)
+
+	prime, err := n.ProbablyPrime(0)
+	fmt.Printf("probablyPrime(%v, 0) = %v\n", n, prime)
+	printResult(t, fmt.Sprintf("%v", prime), "true", err)
+
+	prime, err = n.ProbablyPrime(0, WithLucas(true))
+	fmt.Printf("probablyPrime(%v, 0, lucas) = %v\n", n, prime)
+	printResult(t, fmt.Sprintf("%v", prime), "false", err)
+
+	prime = natStrongLucas(natUint64(3511 * 3511)) // square of Wieferich prime is strong pseudoprime to base 2
+	fmt.Printf("strongLucas(3511^2) = %v\n", prime)
+	printResult(t, fmt.Sprintf("%v", prime), "false", nil)
+
+	p := New()
+	p.PowInt(SetInt64(2), 1279)
+	p.Sub(p, SetInt64(1))
+	prime, err = p.ProbablyPrime(2, WithLucas(true))
+	fmt.Printf("probablyPrime(2^1279 - 1, 2, lucas) = %v\n", prime)
+	printResult(t, fmt.Sprintf("%v", prime), "true", err)
+
+	p.Add(p, SetInt64(2))
+	prime, err = p.ProbablyPrime(2, WithLucas(true))
+	fmt.Printf("probablyPrime(2^1279 + 1, 2, lucas) = %v\n", prime)
+	printResult(t, fmt.Sprintf("%v", prime), "false", err)
+}
+
+func TestErrorsProbablyPrime(t *testing.T) {
+	var cases = []struct {
+		param  string
+		rounds int
+	}{
+		{"1.5", 10},
+		{"0.01", 10},
+		{"NaN", 10},
+		{"Inf", 10},
+		{"7", -1},
+	}
+	fmt.Printf("\nTestErrorsProbablyPrime...\n")
+	for _, c := range cases {
+		fmt.Printf("probablyPrime(%v, %v) = ", c.param, c.rounds)
+		n, err := createBigFloat(t, c.param)
+		if err != nil {
+			continue
+		}
+
+		_, err = n.ProbablyPrime(c.rounds)
+		fmt.Printf("%v\n", err)
+		if err == nil {
+			t.Errorf("probablyPrime(%v, %v) should return error", c.param, c.rounds)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2023 Tihomir Magdic. All rights reserved.
+Use of this source code is governed by a BSD-style
+license that can be found in the LICENSE file.
+*/
+
+package bigfloat
+
+import (
+	"fmt"
+	"stranalyzer"
+)
+
+/*
+For internal use
+Calculates n-th root of BigFloat number rounded with RoundOption
+Returns if result is exact
+*/
+func (f *BigFloat) root(a *BigFloat, n int, ro roundOptionsType) (*BigFloat, bool, error) {
+	decimals := ro.decimalPlaces
+	if decimals < 0 {
+		return nil, false, fmt.Errorf("ERROR: Negative decimal places. Decimal places should be 0 or positive")
+	}
+
+	if n < 1 {
+		return nil, false, fmt.Errorf("ERROR: Root degree should be positive")
+	}
+
+	if err := checkFinite(a); err != nil {
+		return nil, false, err
+	}
+
+	sign := a.GetSign()
+	if sign < 0 && n%2 == 0 && !a.IsInt64(0) {
+		return nil, false, fmt.Errorf("ERROR: Even root of negative number")
+	}
+
+	if a.IsInt64(0) {
+		f.SetInt64(0)
+
+		return f, true, nil
+	}
+
+	if a.isCompact() && a.exponent() > 0 { // root of coefficient for exact root of very large number (e.g. sqrt(1e1000000) = 1e500000)
+		c, e := a.coefficient()
+		m := toNat(c.Abs().Mul10(e % n))
+		if r := natRoot(m, n); natCmp(natPow(r, uint64(n)), m) == 0 {
+			f.analysis = stranalyzer.Shift(r.bigFloat().analysis, e/n)
+			f.Sign(sign)
+
+			return f, true, nil
+		}
+		// inexact root of very large number has all digits of whole number part, so number is expanded in toNat
+	}
+
+	k := decimals + 1 // one more decimal for rounding
+	if n*k < a.decimals() {
+		k = (a.decimals() + n - 1) / n
+	}
+
+	m := toNat(a.Copy().Abs().Mul10(n * k)) // integer number with multiple of n decimals removed (very small number is not expanded)
+	r := natRoot(m, n)
+	exact := natCmp(natPow(r, uint64(n)), m) == 0
+
+	f.analysis = r.bigFloat().Div10(k).analysis
+	f.Sign(sign) // odd root of negative number is negative
+
+	if exact {
+		f.trimDecimals()
+		if f.decimals() <= decimals {
+			return f, true, nil
+		}
+	}
+
+	report(ro.conditions, f.roundCond(decimals, ro.roundingMode, !exact)) // inexact root has non zero digits after calculated digits
+	f.SetDecimals(decimals)
+
+	return f, false, nil
+}
+
+/*
+Calculates square root of BigFloat number with RoundOption:
+
+	decimalPlaces - target decimal places (default is 16)
+	roundingMode - rounding mode of result (default is RoundHalfUp)
+
+Returns if result is exact.
+Exact result is returned without trailing zeroes (e.g. sqrt(2.25) = 1.5), otherwise result is rounded to target decimal places.
+*/
+func (f *BigFloat) Sqrt(a *BigFloat, options ...RoundOption) (*BigFloat, bool, error) {
+	ro, err := mathOptions(options, a)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if a.GetSign() < 0 && !a.IsInt64(0) {
+		return nil, false, fmt.Errorf("ERROR: Square root of negative number")
+	}
+
+	return f.root(a, 2, ro)
+}
+
+/*
+Calculates n-th root of BigFloat number rounded to decimals with RoundOption:
+
+	roundingMode - rounding mode of result (default is RoundHalfUp)
+
+Returns if result is exact.
+Exact result is returned without trailing zeroes (e.g. root(0.001, 3) = 0.1), otherwise result is rounded to target decimal places.
+Odd roots of negative numbers are negative, even roots of negative numbers return error.
+*/
+func (f *BigFloat) NthRoot(a *BigFloat, n int, decimals int, options ...RoundOption) (*BigFloat, bool, error) {
+	ro, err := mathOptions(options, a)
+	if err != nil {
+		return nil, false, err
+	}
+	ro.decimalPlaces = decimals // decimals argument has precedence over decimalPlaces option
+
+	return f.root(a, n, ro)
+}
+
+/*
+Calculates cube root of BigFloat number rounded to decimals with RoundOption
+
+See: NthRoot
+*/
+func (f *BigFloat) Cbrt(a *BigFloat, decimals int, options ...RoundOption) (*BigFloat, bool, error) {
+	return f.NthRoot(a, 3, decimals, options...)
+}
@@ -0,0 +1,250 @@
+package bigfloat
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSqrt(t *testing.T) {
+	var cases = []struct {
+		param    string
+		decimals int
+		expected string
+		exact    bool
+	}{
+		{"0", 10, "0", true},
+		{"1", 10, "1", true},
+		{"4", 10, "2", true},
+		{"2.25", 10, "1.5", true},
+		{"0.0001", 10, "0.01", true},
+		{"144.000", 0, "12", true},
+		{"1524157875019052100", 5, "1234567890", true},
+		{"2", 10, "1.4142135624", false},
+		{"2", 30, "1.414213562373095048801688724210", false},
+		{"3", 0, "2", false},
+		{"10", 5, "3.16228", false},
+		{"0.5", 20, "0.70710678118654752440", false},
+		{"0.0001", 1, "0.0", false},
+		{"99.9999", 3, "10.000", false},
+		{"123456789.987654321", 12, "11111.111105000000", false},
+	}
+	fmt.Printf("\nTestSqrt...\n")
+	for _, c := range cases {
+		fmt.Printf("sqrt(%v, %v) = ", c.param, c.decimals)
+		n1, err := createBigFloat(t, c.param)
+		if err != nil {
+			continue
+		}
+
+		n2 := New()
+		_, exact, err := n2.Sqrt(n1, WithDecimalPlaces(c.decimals))
+
+		expectedStr := fmt.Sprintf("%v (exact: %v)", c.expected, c.exact)
+		result := fmt.Sprintf("%v (exact: %v)", n2.String(), exact)
+
+		fmt.Printf("%v\n", result)
+		printResult(t, result, expectedStr, err)
+	}
+}
+
+func TestSqrtDefaultDecimals(t *testing.T) {
+	fmt.Printf("\nTestSqrtDefaultDecimals...\n")
+	fmt.Printf("sqrt(2) = ")
+	n1 := SetInt64(2)
+	n2 := New()
+	_, _, err := n2.Sqrt(n1)
+
+	result := n2.String()
+	fmt.Printf("%v\n", result)
+	printResult(t, result, "1.4142135623730950", err)
+}
+
+func TestErrorsSqrt(t *testing.T) {
+	var cases = []struct {
+		param    string
+		decimals int
+	}{
+		{"-1", 10},
+		{"-0.0001", 10},
+		{"2", -1},
+	}
+
+	fmt.Printf("\nTestErrorsSqrt...\n")
+	for _, c := range cases {
+		fmt.Printf("sqrt(%v, %v) = ", c.param, c.decimals)
+		n1, err := createBigFloat(t, c.param)
+		if err != nil {
+			continue
+		}
+		func() {
+			defer func() {
+				if err := recover(); err != nil {
+					fmt.Printf("\nOK: panic occurred: %v\n", err)
+				}
+			}()
+
+			n2 := New()
+			_, _, err := n2.Sqrt(n1, WithDecimalPlaces(c.decimals))
+			if err != nil {
+				panic(err)
+			}
+
+			errorStr := fmt.Sprintf("%v should raise panic", c)
+			fmt.Printf("\n" + errorStr + "\n")
+			t.Errorf(errorStr)
+		}()
+	}
+}
+
+func TestNthRoot(t *testing.T) {
+	var cases = []struct {
+		param    string
+		n        int
+		decimals int
+		expected string
+		exact    bool
+	}{
+		{"0", 3, 10, "0", true},
+		{"5.5", 1, 10, "5.5", true},
+		{"27", 3, 10, "3", true},
+		{"-8", 3, 10, "-2", true},
+		{"0.001", 3, 10, "0.1", true},
+		{"1024", 10, 5, "2", true},
+		{"-0.00032", 5, 10, "-0.2", true},
+		{"16", 4, 10, "2", true},
+		{"2", 3, 30, "1.259921049894873164767210607278", false},
+		{"-2", 3, 20, "-1.25992104989487316477", false},
+		{"10", 5, 15, "1.584893192461113", false},
+		{"1.0375", 12, 20, "1.00307254170325553603", false},
+		{"123456789", 7, 10, "14.3195942085", false},
+		{"0.5", 4, 25, "0.8408964152537145430311255", false},
+		{"0.001", 3, 0, "0", false},
+	}
+	fmt.Printf("\nTestNthRoot...\n")
+	for _, c := range cases {
+		fmt.Printf("root(%v, %v, %v) = ", c.param, c.n, c.decimals)
+		n1, err := createBigFloat(t, c.param)
+		if err != nil {
+			continue
+		}
+
+		n2 := New()
+		_, exact, err := n2.NthRoot(n1, c.n, c.decimals)
+
+		expectedStr := fmt.Sprintf("%v (exact: %v)", c.expected, c.exact)
+		result := fmt.Sprintf("%v (exact: %v)", n2.String(), exact)
+
+		fmt.Printf("%v\n", result)
+		printResult(t, result, expectedStr, err)
+	}
+}
+
+func TestNthRootLargeDegree(t *testing.T) {
+	var cases = []struct {
+		param    string
+		n        int
+		decimals int
+		expected string
+		exact    bool
+	}{
+		{"123456789.123456789", 52, 200, "1.43088936973972713164691570970732565584570908718521649048575951029655816755522051795161445241884667203186374091603270012567823733704125201820687612507250318805447840670156451545788286396891925305182263", false},
+		{"123456789.123456789", 252, 200, "1.07673588287133454512078778193898085511878250555328907430641162739649027169433503448126012964276734648804167350928397279999981693369194208463304196412163136958609128281790026062137380369956432844343388", false},
+		{"123456789.123456789", 360, 200, "1.05311653210772079782320898815567849983896289551570152898381938157358384074631742553004548344240171235419373370517619169682459957097506015110884837697698876059310024097178394052378647856350039967970877", false},
+		{"1.5", 360, 20, "1.00112692647195481441", false},
+		{"1e360", 360, 200, "10", true},
+		{"0.000001", 1000, 10, "0.9862794856", false},
+	}
+	fmt.Printf("\nTestNthRootLargeDegree...\n")
+	for _, c := range cases {
+		fmt.Printf("root(%v, %v, %v) = ", c.param, c.n, c.decimals)
+		n1, err := createBigFloat(t, c.param)
+		if err != nil {
+			continue
+		}
+
+		n2 := New()
+		exact := false
+		allocated := allocatedBytes(func() {
+			_, exact, err = n2.NthRoot(n1, c.n, c.decimals)
+		})
+		if allocated > 1e7 { // Newton iteration starts close to root, so there are a few iterations
+			t.Errorf("root(%v, %v, %v) allocated %v bytes", c.param, c.n, c.decimals, allocated)
+		}
+
+		expectedStr := fmt.Sprintf("%v (exact: %v)", c.expected, c.exact)
+		result := fmt.Sprintf("%v (exact: %v)", n2.String(), exact)
+
+		fmt.Printf("%v\n", result)
+		printResult(t, result, expectedStr, err)
+	}
+}
+
+func TestCbrt(t *testing.T) {
+	var cases = []struct {
+		param    string
+		decimals int
+		expected string
+		exact    bool
+	}{
+		{"125", 10, "5", true},
+		{"-0.027", 10, "-0.3", true},
+		{"2", 30, "1.259921049894873164767210607278", false},
+	}
+	fmt.Printf("\nTestCbrt...\n")
+	for _, c := range cases {
+		fmt.Printf("cbrt(%v, %v) = ", c.param, c.decimals)
+		n1, err := createBigFloat(t, c.param)
+		if err != nil {
+			continue
+		}
+
+		n2 := New()
+		_, exact, err := n2.Cbrt(n1, c.decimals)
+
+		expectedStr := fmt.Sprintf("%v (exact: %v)", c.expected, c.exact)
+		result := fmt.Sprintf("%v (exact: %v)", n2.String(), exact)
+
+		fmt.Printf("%v\n", result)
+		printResult(t, result, expectedStr, err)
+	}
+}
+
+func TestErrorsNthRoot(t *testing.T) {
+	var cases = []struct {
+		param    string
+		n        int
+		decimals int
+	}{
+		{"-16", 4, 10},
+		{"-0.0001", 2, 10},
+		{"2", 0, 10},
+		{"2", -3, 10},
+		{"2", 3, -1},
+	}
+
+	fmt.Printf("\nTestErrorsNthRoot...\n")
+	for _, c := range cases {
+		fmt.Printf("root(%v, %v, %v) = ", c.param, c.n, c.decimals)
+		n1, err := createBigFloat(t, c.param)
+		if err != nil {
+			continue
+		}
+		func() {
+			defer func() {
+				if err := recover(); err != nil {
+					fmt.Printf("\nOK: panic occurred: %v\n", err)
+				}
+			}()
+
+			n2 := New()
+			_, _, err := n2.NthRoot(n1, c.n, c.decimals)
+			if err != nil {
+				panic(err)
+			}
+
+			errorStr := fmt.Sprintf("%v should raise panic", c)
+			fmt.Printf("\n" + errorStr + "\n")
+			t.Errorf(errorStr)
+		}()
+	}
+}
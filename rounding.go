@@ -0,0 +1,332 @@
+/*
+Copyright 2023 Tihomir Magdic. All rights reserved.
+Use of this source code is governed by a BSD-style
+license that can be found in the LICENSE file.
+*/
+
+package bigfloat
+
+import (
+	"fmt"
+	"stranalyzer"
+)
+
+/*
+Rounding mode defines how digits are dropped in rounding operations
+
+See: WithRoundingMode, WithDivRoundingMode
+*/
+type RoundingMode int
+
+const (
+	RoundHalfUp   RoundingMode = iota // to nearest, ties away from zero (default)
+	RoundHalfDown                     // to nearest, ties toward zero
+	RoundHalfEven                     // to nearest, ties to even digit (banker's rounding)
+	RoundUp                           // away from zero
+	RoundDown                         // toward zero (truncation)
+	RoundCeiling                      // toward positive infinity
+	RoundFloor                        // toward negative infinity
+)
+
+var roundingModeNames = []string{"HalfUp", "HalfDown", "HalfEven", "Up", "Down", "Ceiling", "Floor"}
+
+/*
+Returns name of rounding mode
+*/
+func (m RoundingMode) String() string {
+	if !m.valid() {
+		return fmt.Sprintf("RoundingMode(%d)", int(m))
+	}
+
+	return roundingModeNames[m]
+}
+
+/*
+For internal use
+Returns if rounding mode is one of defined modes
+*/
+func (m RoundingMode) valid() bool {
+	return m >= RoundHalfUp && m <= RoundFloor
+}
+
+/*
+Function defines rounding mode in rounding
+
+See: Round, Trunc
+*/
+func WithRoundingMode(mode RoundingMode) RoundOption {
+	return func(ro *roundOptionsType) {
+		ro.roundingMode = mode
+	}
+}
+
+/*
+Function defines rounding mode of last decimal in division operation
+Effective only with target decimal places
+
+See: Div
+*/
+func WithDivRoundingMode(mode RoundingMode) DivOption {
+	return func(ro *divOptionsType) {
+		ro.roundingMode = mode
+	}
+}
+
+/*
+For internal use
+Rounds number to n decimals with rounding mode
+Argument sticky tells if there are non zero digits after the last digit of number (e.g. remainder of division)
+*/
+func (f *BigFloat) round(n int, mode RoundingMode, sticky bool) *BigFloat {
+	f.roundCond(n, mode, sticky)
+
+	return f
+}
+
+/*
+For internal use
+Rounds number to n decimals with rounding mode (see round)
+Returns raised conditions (Rounded if digits are dropped, Inexact if non zero digits are dropped)
+*/
+func (f *BigFloat) roundCond(n int, mode RoundingMode, sticky bool) Condition {
+	if n < 0 {
+		panic("Invalid decimal number")
+	}
+	if !mode.valid() {
+		panic("ERROR: Invalid rounding mode")
+	}
+
+	if f.isSpecial() { // NaN and infinity are not rounded
+		return 0
+	}
+
+	if f.isCompact() { // compact form is expanded only if digits are needed
+		if f.exponent() < -n { // very small number, rounding digit is 0 followed by non zero digits
+			f.analysis = stranalyzer.Shift(SetInt64(int64(f.analysis.Sign)).analysis, -(n + 2))
+		} else if n >= f.decimals() && !sticky { // nothing to drop
+			return 0
+		}
+	}
+
+	f.expand()
+	if n >= f.analysis.Decimals {
+		if !sticky { // nothing to drop
+			return 0
+		}
+		f.SetDecimals(n + 1) // dropped digit is 0 followed by sticky digits
+	}
+
+	pos := f.analysis.Len - f.analysis.Decimals + n // position of digit for rounding
+	d := f.analysis.Norm[pos]                       // digit for rounding
+	for i := pos + 1; i < f.analysis.Len && !sticky; i++ {
+		sticky = f.analysis.Norm[i] != '0' // non zero digit after digit for rounding
+	}
+	odd := (f.analysis.Norm[pos-1]-'0')%2 == 1 // last kept digit (there is always whole number digit)
+
+	f.analysis.Len -= f.analysis.Decimals - n // fix the length
+	f.analysis.Decimals = n                   // fix decimals
+	f.analysis.Norm = f.analysis.Norm[:pos]
+
+	inexact := d != '0' || sticky
+	up := false // increment of last kept digit
+	switch mode {
+	case RoundHalfUp:
+		up = d >= '5'
+	case RoundHalfDown:
+		up = d > '5' || (d == '5' && sticky)
+	case RoundHalfEven:
+		up = d > '5' || (d == '5' && (sticky || odd))
+	case RoundUp:
+		up = inexact
+	case RoundCeiling:
+		up = inexact && f.analysis.Sign > 0
+	case RoundFloor:
+		up = inexact && f.analysis.Sign < 0
+	}
+
+	if up { // rounding up
+		c := BigFloat{}        // create new BigFloat number
+		c.SetInt64(1).Div10(n) // with rounding digit
+		c.Sign(f.GetSign())
+		f.Add(f, &c) // calculate new number with addition
+	}
+
+	f.Sign(f.analysis.Sign)
+
+	if inexact {
+		return Rounded | Inexact
+	}
+
+	return Rounded
+}
+
+/*
+Rounds number to n significant digits
+RoundOption defines rounding mode (default is RoundHalfUp)
+
+Returns if any non zero digit was discarded.
+Carry into a new leading digit keeps n significant digits (e.g. 999.96 rounded to 4 significant digits is 1000).
+*/
+func (f *BigFloat) RoundSig(n int, options ...RoundOption) (*BigFloat, bool) {
+	if n < 1 {
+		panic("ERROR: Significant digits should be positive")
+	}
+
+	ro := roundOptionsType{}
+	for _, option := range options {
+		option(&ro)
+	}
+
+	cond := f.roundSig(n, ro.roundingMode, false)
+	report(ro.conditions, cond)
+
+	return f, cond&Inexact != 0
+}
+
+/*
+For internal use
+Rounds number to n significant digits with rounding mode
+Argument sticky tells if there are non zero digits after the last digit of number (see round)
+Returns raised conditions (see roundCond)
+*/
+func (f *BigFloat) roundSig(n int, mode RoundingMode, sticky bool) Condition {
+	if f.IsInt64(0) || f.isSpecial() {
+		return 0
+	}
+
+	if f.isCompact() { // rounding of coefficient
+		c, e := f.coefficient()
+		cond := c.roundSig(n, mode, sticky)
+		f.analysis = stranalyzer.Shift(c.analysis, e)
+
+		return cond
+	}
+
+	e := f.exponent()
+	d := n - e // decimals of last significant digit (negative in whole number part)
+	if d >= f.analysis.Decimals && !sticky {
+		return 0
+	}
+
+	var cond Condition
+	if d >= 0 {
+		cond = f.roundCond(d, mode, sticky)
+	} else { // rounding in whole number part
+		cond = f.Div10(-d).roundCond(0, mode, sticky)
+		f.Mul10(-d)
+	}
+
+	if d > 0 && f.exponent() > e { // carry into new leading digit
+		f.SetDecimals(d - 1)
+	}
+
+	return cond
+}
+
+/*
+Rounds number toward negative infinity to decimals
+Result has exactly decimals decimal places (see SetDecimals)
+*/
+func (f *BigFloat) Floor(decimals int) *BigFloat {
+	if decimals < 0 {
+		panic("ERROR: Negative decimal places. Decimal places should be 0 or positive")
+	}
+
+	return f.round(decimals, RoundFloor, false).SetDecimals(decimals)
+}
+
+/*
+Rounds number toward positive infinity to decimals
+Result has exactly decimals decimal places (see SetDecimals)
+*/
+func (f *BigFloat) Ceil(decimals int) *BigFloat {
+	if decimals < 0 {
+		panic("ERROR: Negative decimal places. Decimal places should be 0 or positive")
+	}
+
+	return f.round(decimals, RoundCeiling, false).SetDecimals(decimals)
+}
+
+/*
+Rounds number to nearest multiple of positive step (e.g. 0.05 for cash rounding or 0.25 for tick size)
+RoundOption defines rounding mode (default is RoundHalfUp)
+
+Result has the same number of decimal places as step.
+*/
+func (f *BigFloat) RoundToIncrement(step *BigFloat, options ...RoundOption) *BigFloat {
+	if step.GetSign() < 0 || step.IsInt64(0) {
+		panic("ERROR: Increment should be positive")
+	}
+
+	ro := roundOptionsType{}
+	for _, option := range options {
+		option(&ro)
+	}
+
+	q := New()
+	q.Div(f, step, WithDivDecimalPlaces(0), WithDivRoundingMode(ro.roundingMode)) // number of steps
+	f.Mul(q, step)
+
+	return f.SetDecimals(step.decimals())
+}
+
+/*
+Function type for quantize operation.
+
+See: Quantize
+*/
+type QuantizeOption func(*quantizeOptionsType)
+
+type quantizeOptionsType struct {
+	maxIntDigits int
+}
+
+/*
+Function defines maximum number of whole number digits of quantized number
+Operation returns error when limit is exceeded
+*/
+func WithQuantizeMaxIntDigits(maxIntDigits int) QuantizeOption {
+	return func(qo *quantizeOptionsType) {
+		qo.maxIntDigits = maxIntDigits
+	}
+}
+
+/*
+Sets x with exactly the same number of decimal places as ref, rounded with rounding mode (General Decimal Arithmetic quantize operation)
+QuantizeOption:
+
+	maxIntDigits - maximum number of whole number digits of result (default is 0 - unlimited)
+
+Unlike SetDecimals, dropped decimals are rounded, not truncated. On error f is not changed.
+Returns error for NaN or infinity.
+*/
+func (f *BigFloat) Quantize(x, ref *BigFloat, mode RoundingMode, options ...QuantizeOption) (*BigFloat, error) {
+	qo := quantizeOptionsType{ // default option values
+		maxIntDigits: 0,
+	}
+	for _, option := range options {
+		option(&qo)
+	}
+
+	if !mode.valid() {
+		return nil, fmt.Errorf("ERROR: Invalid rounding mode")
+	}
+
+	if err := checkFinite(x, ref); err != nil {
+		return nil, err
+	}
+
+	r := x.clone().round(ref.decimals(), mode, false).SetDecimals(ref.decimals())
+
+	intDigits := r.analysis.Len - r.analysis.Decimals
+	if intDigits == 1 && r.analysis.Norm[0] == '0' { // 0 in whole number part
+		intDigits = 0
+	}
+	if qo.maxIntDigits > 0 && intDigits > qo.maxIntDigits {
+		return nil, fmt.Errorf("ERROR: Quantized number exceeds maximum number of whole number digits (%d)", qo.maxIntDigits)
+	}
+
+	f.analysis = r.analysis
+
+	return f, nil
+}
@@ -0,0 +1,720 @@
+package bigfloat
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestRoundingModes(t *testing.T) {
+	var cases = []struct {
+		param    string
+		decimals int
+		mode     RoundingMode
+		expected string
+	}{
+		{"2.5", 0, RoundHalfUp, "3"},
+		{"-2.5", 0, RoundHalfUp, "-3"},
+		{"3.5", 0, RoundHalfUp, "4"},
+		{"-3.5", 0, RoundHalfUp, "-4"},
+		{"-2.51", 0, RoundHalfUp, "-3"},
+		{"-1.25", 1, RoundHalfUp, "-1.3"},
+		{"-1.35", 1, RoundHalfUp, "-1.4"},
+		{"-1.2501", 1, RoundHalfUp, "-1.3"},
+		{"-0.001", 2, RoundHalfUp, "0.00"},
+		{"1.001", 2, RoundHalfUp, "1.00"},
+		{"-7", 0, RoundHalfUp, "-7"},
+		{"-0.5", 0, RoundHalfUp, "-1"},
+		{"2.5", 0, RoundHalfDown, "2"},
+		{"-2.5", 0, RoundHalfDown, "-2"},
+		{"3.5", 0, RoundHalfDown, "3"},
+		{"-3.5", 0, RoundHalfDown, "-3"},
+		{"-2.51", 0, RoundHalfDown, "-3"},
+		{"-1.25", 1, RoundHalfDown, "-1.2"},
+		{"-1.35", 1, RoundHalfDown, "-1.3"},
+		{"-1.2501", 1, RoundHalfDown, "-1.3"},
+		{"-0.001", 2, RoundHalfDown, "0.00"},
+		{"1.001", 2, RoundHalfDown, "1.00"},
+		{"-7", 0, RoundHalfDown, "-7"},
+		{"-0.5", 0, RoundHalfDown, "0"},
+		{"2.5", 0, RoundHalfEven, "2"},
+		{"-2.5", 0, RoundHalfEven, "-2"},
+		{"3.5", 0, RoundHalfEven, "4"},
+		{"-3.5", 0, RoundHalfEven, "-4"},
+		{"-2.51", 0, RoundHalfEven, "-3"},
+		{"-1.25", 1, RoundHalfEven, "-1.2"},
+		{"-1.35", 1, RoundHalfEven, "-1.4"},
+		{"-1.2501", 1, RoundHalfEven, "-1.3"},
+		{"-0.001", 2, RoundHalfEven, "0.00"},
+		{"1.001", 2, RoundHalfEven, "1.00"},
+		{"-7", 0, RoundHalfEven, "-7"},
+		{"-0.5", 0, RoundHalfEven, "0"},
+		{"2.5", 0, RoundUp, "3"},
+		{"-2.5", 0, RoundUp, "-3"},
+		{"3.5", 0, RoundUp, "4"},
+		{"-3.5", 0, RoundUp, "-4"},
+		{"-2.51", 0, RoundUp, "-3"},
+		{"-1.25", 1, RoundUp, "-1.3"},
+		{"-1.35", 1, RoundUp, "-1.4"},
+		{"-1.2501", 1, RoundUp, "-1.3"},
+		{"-0.001", 2, RoundUp, "-0.01"},
+		{"1.001", 2, RoundUp, "1.01"},
+		{"-7", 0, RoundUp, "-7"},
+		{"-0.5", 0, RoundUp, "-1"},
+		{"2.5", 0, RoundDown, "2"},
+		{"-2.5", 0, RoundDown, "-2"},
+		{"3.5", 0, RoundDown, "3"},
+		{"-3.5", 0, RoundDown, "-3"},
+		{"-2.51", 0, RoundDown, "-2"},
+		{"-1.25", 1, RoundDown, "-1.2"},
+		{"-1.35", 1, RoundDown, "-1.3"},
+		{"-1.2501", 1, RoundDown, "-1.2"},
+		{"-0.001", 2, RoundDown, "0.00"},
+		{"1.001", 2, RoundDown, "1.00"},
+		{"-7", 0, RoundDown, "-7"},
+		{"-0.5", 0, RoundDown, "0"},
+		{"2.5", 0, RoundCeiling, "3"},
+		{"-2.5", 0, RoundCeiling, "-2"},
+		{"3.5", 0, RoundCeiling, "4"},
+		{"-3.5", 0, RoundCeiling, "-3"},
+		{"-2.51", 0, RoundCeiling, "-2"},
+		{"-1.25", 1, RoundCeiling, "-1.2"},
+		{"-1.35", 1, RoundCeiling, "-1.3"},
+		{"-1.2501", 1, RoundCeiling, "-1.2"},
+		{"-0.001", 2, RoundCeiling, "0.00"},
+		{"1.001", 2, RoundCeiling, "1.01"},
+		{"-7", 0, RoundCeiling, "-7"},
+		{"-0.5", 0, RoundCeiling, "0"},
+		{"2.5", 0, RoundFloor, "2"},
+		{"-2.5", 0, RoundFloor, "-3"},
+		{"3.5", 0, RoundFloor, "3"},
+		{"-3.5", 0, RoundFloor, "-4"},
+		{"-2.51", 0, RoundFloor, "-3"},
+		{"-1.25", 1, RoundFloor, "-1.3"},
+		{"-1.35", 1, RoundFloor, "-1.4"},
+		{"-1.2501", 1, RoundFloor, "-1.3"},
+		{"-0.001", 2, RoundFloor, "-0.01"},
+		{"1.001", 2, RoundFloor, "1.00"},
+		{"-7", 0, RoundFloor, "-7"},
+		{"-0.5", 0, RoundFloor, "-1"},
+	}
+	fmt.Printf("\nTestRoundingModes...\n")
+	for _, c := range cases {
+		fmt.Printf("round(%v, %v, %v) = ", c.param, c.decimals, c.mode)
+		n1, err := createBigFloat(t, c.param)
+		if err != nil {
+			continue
+		}
+
+		n1.Round(c.decimals, WithRoundingMode(c.mode))
+
+		result := n1.String()
+		fmt.Printf("%v\n", result)
+		printResult(t, result, c.expected, err)
+	}
+}
+
+func TestDivRoundingModes(t *testing.T) {
+	var cases = []struct {
+		param1   string
+		param2   string
+		decimals int
+		mode     RoundingMode
+		expected string
+	}{
+		{"-2", "3", 3, RoundHalfUp, "-0.667"},
+		{"-1", "8", 2, RoundHalfUp, "-0.13"},
+		{"-5", "2", 0, RoundHalfUp, "-3"},
+		{"7", "-16", 3, RoundHalfUp, "-0.438"},
+		{"-1", "3", 0, RoundHalfUp, "0"},
+		{"-2", "3", 3, RoundHalfDown, "-0.667"},
+		{"-1", "8", 2, RoundHalfDown, "-0.12"},
+		{"-5", "2", 0, RoundHalfDown, "-2"},
+		{"7", "-16", 3, RoundHalfDown, "-0.437"},
+		{"-1", "3", 0, RoundHalfDown, "0"},
+		{"-2", "3", 3, RoundHalfEven, "-0.667"},
+		{"-1", "8", 2, RoundHalfEven, "-0.12"},
+		{"-5", "2", 0, RoundHalfEven, "-2"},
+		{"7", "-16", 3, RoundHalfEven, "-0.438"},
+		{"-1", "3", 0, RoundHalfEven, "0"},
+		{"-2", "3", 3, RoundUp, "-0.667"},
+		{"-1", "8", 2, RoundUp, "-0.13"},
+		{"-5", "2", 0, RoundUp, "-3"},
+		{"7", "-16", 3, RoundUp, "-0.438"},
+		{"-1", "3", 0, RoundUp, "-1"},
+		{"-2", "3", 3, RoundDown, "-0.666"},
+		{"-1", "8", 2, RoundDown, "-0.12"},
+		{"-5", "2", 0, RoundDown, "-2"},
+		{"7", "-16", 3, RoundDown, "-0.437"},
+		{"-1", "3", 0, RoundDown, "0"},
+		{"-2", "3", 3, RoundCeiling, "-0.666"},
+		{"-1", "8", 2, RoundCeiling, "-0.12"},
+		{"-5", "2", 0, RoundCeiling, "-2"},
+		{"7", "-16", 3, RoundCeiling, "-0.437"},
+		{"-1", "3", 0, RoundCeiling, "0"},
+		{"-2", "3", 3, RoundFloor, "-0.667"},
+		{"-1", "8", 2, RoundFloor, "-0.13"},
+		{"-5", "2", 0, RoundFloor, "-3"},
+		{"7", "-16", 3, RoundFloor, "-0.438"},
+		{"-1", "3", 0, RoundFloor, "-1"},
+	}
+	fmt.Printf("\nTestDivRoundingModes...\n")
+	for _, c := range cases {
+		fmt.Printf("div(%v, %v, %v, %v) = ", c.param1, c.param2, c.decimals, c.mode)
+		n1, n2, err := create2BigFloats(t, c.param1, c.param2)
+		if err != nil {
+			continue
+		}
+
+		n3 := New()
+		_, _, err = n3.Div(n1, n2, WithDivDecimalPlaces(c.decimals), WithDivRoundingMode(c.mode))
+
+		result := n3.String()
+		fmt.Printf("%v\n", result)
+		printResult(t, result, c.expected, err)
+	}
+}
+
+func TestSqrtRoundingModes(t *testing.T) {
+	var cases = []struct {
+		param    string
+		decimals int
+		mode     RoundingMode
+		expected string
+	}{
+		{"2", 0, RoundHalfUp, "1"},
+		{"1.0000001", 1, RoundHalfUp, "1.0"},
+		{"6.25", 0, RoundHalfUp, "3"},
+		{"2", 3, RoundHalfUp, "1.414"},
+		{"2", 0, RoundHalfDown, "1"},
+		{"1.0000001", 1, RoundHalfDown, "1.0"},
+		{"6.25", 0, RoundHalfDown, "2"},
+		{"2", 3, RoundHalfDown, "1.414"},
+		{"2", 0, RoundHalfEven, "1"},
+		{"1.0000001", 1, RoundHalfEven, "1.0"},
+		{"6.25", 0, RoundHalfEven, "2"},
+		{"2", 3, RoundHalfEven, "1.414"},
+		{"2", 0, RoundUp, "2"},
+		{"1.0000001", 1, RoundUp, "1.1"},
+		{"6.25", 0, RoundUp, "3"},
+		{"2", 3, RoundUp, "1.415"},
+		{"2", 0, RoundDown, "1"},
+		{"1.0000001", 1, RoundDown, "1.0"},
+		{"6.25", 0, RoundDown, "2"},
+		{"2", 3, RoundDown, "1.414"},
+		{"2", 0, RoundCeiling, "2"},
+		{"1.0000001", 1, RoundCeiling, "1.1"},
+		{"6.25", 0, RoundCeiling, "3"},
+		{"2", 3, RoundCeiling, "1.415"},
+		{"2", 0, RoundFloor, "1"},
+		{"1.0000001", 1, RoundFloor, "1.0"},
+		{"6.25", 0, RoundFloor, "2"},
+		{"2", 3, RoundFloor, "1.414"},
+	}
+	fmt.Printf("\nTestSqrtRoundingModes...\n")
+	for _, c := range cases {
+		fmt.Printf("sqrt(%v, %v, %v) = ", c.param, c.decimals, c.mode)
+		n1, err := createBigFloat(t, c.param)
+		if err != nil {
+			continue
+		}
+
+		n2 := New()
+		_, _, err = n2.Sqrt(n1, WithDecimalPlaces(c.decimals), WithRoundingMode(c.mode))
+
+		result := n2.String()
+		fmt.Printf("%v\n", result)
+		printResult(t, result, c.expected, err)
+	}
+}
+
+func TestMathRoundingModes(t *testing.T) {
+	four, _ := SetString("4")
+	x, _ := SetString("6.25")
+	y, _ := SetString("1.5")
+	pow := func(a, b *BigFloat) func(options ...RoundOption) (*BigFloat, error) {
+		return func(o ...RoundOption) (*BigFloat, error) {
+			ro := roundOptionsType{}
+			for _, option := range o {
+				option(&ro)
+			}
+			return New().Pow(a, b, WithPowDecimalPlaces(ro.decimalPlaces), WithPowRoundingMode(ro.roundingMode))
+		}
+	}
+	cbrt := func(a int64) func(options ...RoundOption) (*BigFloat, error) {
+		return func(o ...RoundOption) (*BigFloat, error) {
+			r, _, err := New().Cbrt(SetInt64(a), 3, o...)
+			return r, err
+		}
+	}
+	nthRoot := func(a int64, n int) func(options ...RoundOption) (*BigFloat, error) {
+		return func(o ...RoundOption) (*BigFloat, error) {
+			r, _, err := New().NthRoot(SetInt64(a), n, 3, o...)
+			return r, err
+		}
+	}
+	expTiny := func(o ...RoundOption) (*BigFloat, error) { // positive result below 10^-13
+		x, _ := SetString("-198.0675")
+		return New().Exp(x, 12, o...)
+	}
+	var cases = []struct {
+		name     string
+		fn       func(options ...RoundOption) (*BigFloat, error)
+		decimals int
+		mode     RoundingMode
+		expected string
+	}{
+		{"pow(4, 1.5)", pow(four, y), 10, RoundCeiling, "8.0000000000"},
+		{"pow(4, 1.5)", pow(four, y), 10, RoundUp, "8.0000000000"},
+		{"pow(4, 1.5)", pow(four, y), 10, RoundFloor, "8.0000000000"},
+		{"pow(4, 1.5)", pow(four, y), 10, RoundDown, "8.0000000000"},
+		{"pow(6.25, 1.5)", pow(x, y), 2, RoundHalfUp, "15.63"},
+		{"pow(6.25, 1.5)", pow(x, y), 2, RoundHalfDown, "15.62"},
+		{"pow(6.25, 1.5)", pow(x, y), 2, RoundHalfEven, "15.62"},
+		{"pow(2, 1.5)", pow(SetInt64(2), y), 10, RoundCeiling, "2.8284271248"},
+		{"pow(2, 1.5)", pow(SetInt64(2), y), 10, RoundFloor, "2.8284271247"},
+		{"acos(1)", func(o ...RoundOption) (*BigFloat, error) { return New().Acos(SetInt64(1), o...) }, 10, RoundUp, "0.0000000000"},
+		{"acos(1)", func(o ...RoundOption) (*BigFloat, error) { return New().Acos(SetInt64(1), o...) }, 10, RoundFloor, "0.0000000000"},
+		{"acos(-1)", func(o ...RoundOption) (*BigFloat, error) { return New().Acos(SetInt64(-1), o...) }, 10, RoundUp, "3.1415926536"},
+		{"acos(-1)", func(o ...RoundOption) (*BigFloat, error) { return New().Acos(SetInt64(-1), o...) }, 10, RoundDown, "3.1415926535"},
+		{"asin(0)", func(o ...RoundOption) (*BigFloat, error) { return New().Asin(SetInt64(0), o...) }, 10, RoundCeiling, "0.0000000000"},
+		{"cos(0)", func(o ...RoundOption) (*BigFloat, error) { return New().Cos(SetInt64(0), o...) }, 10, RoundUp, "1.0000000000"},
+		{"cos(0)", func(o ...RoundOption) (*BigFloat, error) { return New().Cos(SetInt64(0), o...) }, 10, RoundFloor, "1.0000000000"},
+		{"ln(1)", func(o ...RoundOption) (*BigFloat, error) { return New().Ln(SetInt64(1), o...) }, 10, RoundUp, "0.0000000000"},
+		{"log2(8)", func(o ...RoundOption) (*BigFloat, error) { return New().Log2(SetInt64(8), o...) }, 10, RoundCeiling, "3.0000000000"},
+		{"log2(8)", func(o ...RoundOption) (*BigFloat, error) { return New().Log2(SetInt64(8), o...) }, 10, RoundFloor, "3.0000000000"},
+		{"cosh(0)", func(o ...RoundOption) (*BigFloat, error) { return New().Cosh(SetInt64(0), o...) }, 10, RoundUp, "1.0000000000"},
+		{"atan(-1)", func(o ...RoundOption) (*BigFloat, error) { return New().Atan(SetInt64(-1), o...) }, 3, RoundCeiling, "-0.785"},
+		{"atan(-1)", func(o ...RoundOption) (*BigFloat, error) { return New().Atan(SetInt64(-1), o...) }, 3, RoundFloor, "-0.786"},
+		{"exp(0)", func(o ...RoundOption) (*BigFloat, error) { return New().Exp(SetInt64(0), 10, o...) }, 10, RoundUp, "1.0000000000"},
+		{"exp(1)", func(o ...RoundOption) (*BigFloat, error) { return New().Exp(SetInt64(1), 5, o...) }, 5, RoundCeiling, "2.71829"},
+		{"exp(1)", func(o ...RoundOption) (*BigFloat, error) { return New().Exp(SetInt64(1), 5, o...) }, 5, RoundFloor, "2.71828"},
+		{"exp(-198.0675)", expTiny, 12, RoundHalfUp, "0.000000000000"},
+		{"exp(-198.0675)", expTiny, 12, RoundHalfDown, "0.000000000000"},
+		{"exp(-198.0675)", expTiny, 12, RoundHalfEven, "0.000000000000"},
+		{"exp(-198.0675)", expTiny, 12, RoundUp, "0.000000000001"},
+		{"exp(-198.0675)", expTiny, 12, RoundDown, "0.000000000000"},
+		{"exp(-198.0675)", expTiny, 12, RoundCeiling, "0.000000000001"},
+		{"exp(-198.0675)", expTiny, 12, RoundFloor, "0.000000000000"},
+		{"cbrt(-2)", cbrt(-2), 3, RoundCeiling, "-1.259"},
+		{"cbrt(-2)", cbrt(-2), 3, RoundFloor, "-1.260"},
+		{"cbrt(2)", cbrt(2), 3, RoundUp, "1.260"},
+		{"root(32, 5)", nthRoot(32, 5), 3, RoundUp, "2"},
+		{"root(33, 5)", nthRoot(33, 5), 3, RoundDown, "2.012"},
+		{"root(33, 5)", nthRoot(33, 5), 3, RoundUp, "2.013"},
+	}
+	fmt.Printf("\nTestMathRoundingModes...\n")
+	for _, c := range cases {
+		fmt.Printf("%v(%v, %v) = ", c.name, c.decimals, c.mode)
+		r, err := c.fn(WithDecimalPlaces(c.decimals), WithRoundingMode(c.mode))
+
+		result := ""
+		if err == nil {
+			result = r.String()
+		}
+		fmt.Printf("%v\n", result)
+		printResult(t, result, c.expected, err)
+	}
+}
+
+func TestTruncRoundingModes(t *testing.T) {
+	var cases = []struct {
+		param    string
+		mode     RoundingMode
+		expected string
+	}{
+		{"-2.5", RoundHalfUp, "-3"},
+		{"2.5", RoundHalfUp, "3"},
+		{"-0.5", RoundHalfUp, "-1"},
+		{"-3.99", RoundHalfUp, "-4"},
+		{"-2.5", RoundHalfDown, "-2"},
+		{"2.5", RoundHalfDown, "2"},
+		{"-0.5", RoundHalfDown, "0"},
+		{"-3.99", RoundHalfDown, "-4"},
+		{"-2.5", RoundHalfEven, "-2"},
+		{"2.5", RoundHalfEven, "2"},
+		{"-0.5", RoundHalfEven, "0"},
+		{"-3.99", RoundHalfEven, "-4"},
+		{"-2.5", RoundUp, "-3"},
+		{"2.5", RoundUp, "3"},
+		{"-0.5", RoundUp, "-1"},
+		{"-3.99", RoundUp, "-4"},
+		{"-2.5", RoundDown, "-2"},
+		{"2.5", RoundDown, "2"},
+		{"-0.5", RoundDown, "0"},
+		{"-3.99", RoundDown, "-3"},
+		{"-2.5", RoundCeiling, "-2"},
+		{"2.5", RoundCeiling, "3"},
+		{"-0.5", RoundCeiling, "0"},
+		{"-3.99", RoundCeiling, "-3"},
+		{"-2.5", RoundFloor, "-3"},
+		{"2.5", RoundFloor, "2"},
+		{"-0.5", RoundFloor, "-1"},
+		{"-3.99", RoundFloor, "-4"},
+	}
+	fmt.Printf("\nTestTruncRoundingModes...\n")
+	for _, c := range cases {
+		fmt.Printf("trunc(%v, %v) = ", c.param, c.mode)
+		n1, err := createBigFloat(t, c.param)
+		if err != nil {
+			continue
+		}
+
+		n1.Trunc(WithDecimalPlaces(0), WithRoundingMode(c.mode))
+
+		result := n1.String()
+		fmt.Printf("%v\n", result)
+		printResult(t, result, c.expected, err)
+	}
+}
+
+func TestRoundingModeString(t *testing.T) {
+	var cases = []struct {
+		mode     RoundingMode
+		expected string
+	}{
+		{RoundHalfUp, "HalfUp"},
+		{RoundHalfEven, "HalfEven"},
+		{RoundFloor, "Floor"},
+		{RoundingMode(42), "RoundingMode(42)"},
+	}
+	fmt.Printf("\nTestRoundingModeString...\n")
+	for _, c := range cases {
+		result := c.mode.String()
+		fmt.Printf("%v\n", result)
+		printResult(t, result, c.expected, nil)
+	}
+}
+
+func TestErrorsRoundingMode(t *testing.T) {
+	fmt.Printf("\nTestErrorsRoundingMode...\n")
+	func() {
+		defer func() {
+			if err := recover(); err != nil {
+				fmt.Printf("OK: panic occurred: %v\n", err)
+			}
+		}()
+
+		n := SetInt64(2)
+		n.Round(0, WithRoundingMode(RoundingMode(42)))
+
+		errorStr := "invalid rounding mode should raise panic"
+		fmt.Printf(errorStr + "\n")
+		t.Errorf(errorStr)
+	}()
+
+	n := New()
+	_, err := n.Sin(SetInt64(1), WithRoundingMode(RoundingMode(-1)))
+	if err == nil {
+		t.Errorf("invalid rounding mode should return error")
+	} else {
+		fmt.Printf("OK: error: %v\n", err)
+	}
+}
+
+func TestRoundSig(t *testing.T) {
+	var cases = []struct {
+		param     string
+		digits    int
+		expected  string
+		discarded bool
+	}{
+		{"0", 3, "0", false},
+		{"0.000123456", 3, "0.000123", true},
+		{"0.000123456", 1, "0.0001", true},
+		{"98765432.1", 3, "98800000", true},
+		{"98765432.1", 9, "98765432.1", false},
+		{"98765432.1", 10, "98765432.1", false},
+		{"999.96", 4, "1000", true},
+		{"999.94", 4, "999.9", true},
+		{"99960", 3, "100000", true},
+		{"-999.96", 4, "-1000", true},
+		{"-0.0009995", 3, "-0.00100", true},
+		{"123.450", 5, "123.45", false},
+		{"1.5", 1, "2", true},
+		{"0.5", 1, "0.5", false},
+		{"-2.5", 1, "-3", true},
+		{"100", 1, "100", false},
+		{"1234", 2, "1200", true},
+	}
+	fmt.Printf("\nTestRoundSig...\n")
+	for _, c := range cases {
+		fmt.Printf("roundSig(%v, %v) = ", c.param, c.digits)
+		n1, err := createBigFloat(t, c.param)
+		if err != nil {
+			continue
+		}
+
+		_, discarded := n1.RoundSig(c.digits)
+
+		expectedStr := fmt.Sprintf("%v (discarded: %v)", c.expected, c.discarded)
+		result := fmt.Sprintf("%v (discarded: %v)", n1.String(), discarded)
+
+		fmt.Printf("%v\n", result)
+		printResult(t, result, expectedStr, err)
+	}
+}
+
+func TestRoundSigRoundingModes(t *testing.T) {
+	var cases = []struct {
+		param    string
+		digits   int
+		mode     RoundingMode
+		expected string
+	}{
+		{"-98765", 2, RoundDown, "-98000"},
+		{"-98765", 2, RoundFloor, "-99000"},
+		{"-98765", 2, RoundCeiling, "-98000"},
+		{"0.0125", 2, RoundHalfEven, "0.012"},
+		{"0.0135", 2, RoundHalfEven, "0.014"},
+		{"-9.91", 2, RoundUp, "-10"},
+	}
+	fmt.Printf("\nTestRoundSigRoundingModes...\n")
+	for _, c := range cases {
+		fmt.Printf("roundSig(%v, %v, %v) = ", c.param, c.digits, c.mode)
+		n1, err := createBigFloat(t, c.param)
+		if err != nil {
+			continue
+		}
+
+		n1.RoundSig(c.digits, WithRoundingMode(c.mode))
+
+		result := n1.String()
+		fmt.Printf("%v\n", result)
+		printResult(t, result, c.expected, err)
+	}
+}
+
+func TestErrorsRoundSig(t *testing.T) {
+	var cases = []int{0, -1}
+
+	fmt.Printf("\nTestErrorsRoundSig...\n")
+	for _, c := range cases {
+		fmt.Printf("roundSig(1.5, %v) = ", c)
+		func() {
+			defer func() {
+				if err := recover(); err != nil {
+					fmt.Printf("\nOK: panic occurred: %v\n", err)
+				}
+			}()
+
+			n := SetInt64(1)
+			n.RoundSig(c)
+
+			errorStr := fmt.Sprintf("%v should raise panic", c)
+			fmt.Printf("\n" + errorStr + "\n")
+			t.Errorf(errorStr)
+		}()
+	}
+}
+
+func TestFloorCeil(t *testing.T) {
+	var cases = []struct {
+		param    string
+		decimals int
+		floor    string
+		ceil     string
+	}{
+		{"1.234", 2, "1.23", "1.24"},
+		{"-1.234", 2, "-1.24", "-1.23"},
+		{"-1.2", 0, "-2", "-1"},
+		{"-1", 0, "-1", "-1"},
+		{"3.99", 1, "3.9", "4.0"},
+		{"-0.001", 2, "-0.01", "0.00"},
+		{"5", 2, "5.00", "5.00"},
+		{"-1.2300", 2, "-1.23", "-1.23"},
+		{"1.2", 3, "1.200", "1.200"},
+	}
+	fmt.Printf("\nTestFloorCeil...\n")
+	for _, c := range cases {
+		fmt.Printf("floor/ceil(%v, %v) = ", c.param, c.decimals)
+		n1, n2, err := create2BigFloats(t, c.param, c.param)
+		if err != nil {
+			continue
+		}
+
+		n1.Floor(c.decimals)
+		n2.Ceil(c.decimals)
+
+		expectedStr := fmt.Sprintf("%v, %v", c.floor, c.ceil)
+		result := fmt.Sprintf("%v, %v", n1.String(), n2.String())
+
+		fmt.Printf("%v\n", result)
+		printResult(t, result, expectedStr, err)
+	}
+}
+
+func TestRoundToIncrement(t *testing.T) {
+	var cases = []struct {
+		param    string
+		step     string
+		mode     RoundingMode
+		expected string
+	}{
+		{"1.23", "0.05", RoundHalfUp, "1.25"},
+		{"1.22", "0.05", RoundHalfUp, "1.20"},
+		{"1.225", "0.05", RoundHalfUp, "1.25"},
+		{"-1.225", "0.05", RoundHalfUp, "-1.25"},
+		{"-1.225", "0.05", RoundHalfDown, "-1.20"},
+		{"101.37", "0.25", RoundHalfUp, "101.25"},
+		{"101.37", "0.25", RoundFloor, "101.25"},
+		{"-101.37", "0.25", RoundFloor, "-101.50"},
+		{"12.7", "5", RoundHalfUp, "15"},
+		{"12.5", "5", RoundHalfEven, "10"},
+		{"7.5", "5", RoundHalfEven, "10"},
+		{"0.01", "0.05", RoundUp, "0.05"},
+		{"-0.01", "0.05", RoundHalfUp, "0.00"},
+		{"3", "0.5", RoundHalfUp, "3.0"},
+	}
+	fmt.Printf("\nTestRoundToIncrement...\n")
+	for _, c := range cases {
+		fmt.Printf("roundToIncrement(%v, %v, %v) = ", c.param, c.step, c.mode)
+		n1, n2, err := create2BigFloats(t, c.param, c.step)
+		if err != nil {
+			continue
+		}
+
+		n1.RoundToIncrement(n2, WithRoundingMode(c.mode))
+
+		result := n1.String()
+		fmt.Printf("%v\n", result)
+		printResult(t, result, c.expected, err)
+	}
+}
+
+func TestErrorsFloorCeilIncrement(t *testing.T) {
+	var cases = []struct {
+		name string
+		fn   func()
+	}{
+		{"floor(1.5, -1)", func() { SetInt64(1).Floor(-1) }},
+		{"ceil(1.5, -1)", func() { SetInt64(1).Ceil(-1) }},
+		{"roundToIncrement(1, 0)", func() { SetInt64(1).RoundToIncrement(SetInt64(0)) }},
+		{"roundToIncrement(1, -5)", func() { SetInt64(1).RoundToIncrement(SetInt64(-5)) }},
+	}
+
+	fmt.Printf("\nTestErrorsFloorCeilIncrement...\n")
+	for _, c := range cases {
+		fmt.Printf("%v = ", c.name)
+		func() {
+			defer func() {
+				if err := recover(); err != nil {
+					fmt.Printf("\nOK: panic occurred: %v\n", err)
+				}
+			}()
+
+			c.fn()
+
+			errorStr := fmt.Sprintf("%v should raise panic", c.name)
+			fmt.Printf("\n" + errorStr + "\n")
+			t.Errorf(errorStr)
+		}()
+	}
+}
+
+func TestQuantize(t *testing.T) {
+	var cases = []struct {
+		param    string
+		ref      string
+		mode     RoundingMode
+		expected string
+	}{
+		{"19.995", "0.01", RoundHalfUp, "20.00"},
+		{"19.995", "0.01", RoundHalfEven, "20.00"},
+		{"19.985", "0.01", RoundHalfEven, "19.98"},
+		{"-19.985", "0.01", RoundHalfUp, "-19.99"},
+		{"-19.985", "0.01", RoundDown, "-19.98"},
+		{"-19.981", "0.01", RoundFloor, "-19.99"},
+		{"2.17", "0.001", RoundHalfUp, "2.170"},
+		{"2.17", "1", RoundHalfUp, "2"},
+		{"2.5", "5", RoundHalfEven, "2"},
+		{"-0.004", "0.01", RoundHalfUp, "0.00"},
+		{"0", "0.0001", RoundHalfUp, "0.0000"},
+		{"123.456", "100.5", RoundCeiling, "123.5"},
+	}
+	fmt.Printf("\nTestQuantize...\n")
+	for _, c := range cases {
+		fmt.Printf("quantize(%v, %v, %v) = ", c.param, c.ref, c.mode)
+		n1, n2, err := create2BigFloats(t, c.param, c.ref)
+		if err != nil {
+			continue
+		}
+
+		n3 := New()
+		_, err = n3.Quantize(n1, n2, c.mode)
+
+		result := n3.String()
+		fmt.Printf("%v\n", result)
+		printResult(t, result, c.expected, err)
+	}
+}
+
+func TestQuantizeMaxIntDigits(t *testing.T) {
+	var cases = []struct {
+		param    string
+		ref      string
+		digits   int
+		expected string
+	}{
+		{"999.994", "0.01", 3, "999.99"},
+		{"0.5", "1", 1, "1"},
+		{"0.004", "0.01", 1, "0.00"},
+	}
+	fmt.Printf("\nTestQuantizeMaxIntDigits...\n")
+	for _, c := range cases {
+		fmt.Printf("quantize(%v, %v, %v) = ", c.param, c.ref, c.digits)
+		n1, n2, err := create2BigFloats(t, c.param, c.ref)
+		if err != nil {
+			continue
+		}
+
+		n3 := New()
+		_, err = n3.Quantize(n1, n2, RoundHalfUp, WithQuantizeMaxIntDigits(c.digits))
+
+		result := n3.String()
+		fmt.Printf("%v\n", result)
+		printResult(t, result, c.expected, err)
+	}
+}
+
+func TestErrorsQuantize(t *testing.T) {
+	var cases = []struct {
+		param  string
+		ref    string
+		mode   RoundingMode
+		digits int
+	}{
+		{"999.995", "0.01", RoundHalfUp, 3},
+		{"-12345", "1", RoundHalfUp, 4},
+		{"9.5", "1", RoundUp, 1},
+		{"1.5", "1", RoundingMode(42), 0},
+		{"Inf", "1", RoundHalfUp, 0},
+		{"1", "-Inf", RoundHalfUp, 0},
+		{"NaN", "0.1", RoundHalfUp, 0},
+	}
+
+	fmt.Printf("\nTestErrorsQuantize...\n")
+	for _, c := range cases {
+		fmt.Printf("quantize(%v, %v, %v, %v) = ", c.param, c.ref, c.mode, c.digits)
+		n1, n2, err := create2BigFloats(t, c.param, c.ref)
+		if err != nil {
+			continue
+		}
+		func() {
+			defer func() {
+				if err := recover(); err != nil {
+					fmt.Printf("\nOK: panic occurred: %v\n", err)
+				}
+			}()
+
+			n3 := New()
+			_, err := n3.Quantize(n1, n2, c.mode, WithQuantizeMaxIntDigits(c.digits))
+			if err != nil {
+				panic(err)
+			}
+
+			errorStr := fmt.Sprintf("%v should raise panic", c)
+			fmt.Printf("\n" + errorStr + "\n")
+			t.Errorf(errorStr)
+		}()
+	}
+}
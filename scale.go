@@ -0,0 +1,128 @@
+/*
+Copyright 2023 Tihomir Magdic. All rights reserved.
+Use of this source code is governed by a BSD-style
+license that can be found in the LICENSE file.
+*/
+
+package bigfloat
+
+/*
+Scale policy defines number of decimal places of product in multiplication
+By default product has sum of decimal places of both operands, so chains of multiplications
+(e.g. compound interest) produce numbers with a very large number of decimal places
+Policy is given to every operation and there is no package default, because global setting would be shared
+by all goroutines and packages using BigFloat numbers (use Context for common settings of many operations)
+
+See: ScaleUnbounded, ScaleKeepMax, ScaleCap
+*/
+type ScalePolicy struct {
+	kind     int
+	decimals int
+	mode     RoundingMode
+}
+
+const (
+	scaleUnbounded = iota // exact product (default)
+	scaleKeepMax          // product has max decimal places of operands
+	scaleCap              // product has at most decimals decimal places
+)
+
+/*
+Returns scale policy of exact product (default)
+*/
+func ScaleUnbounded() ScalePolicy {
+	return ScalePolicy{kind: scaleUnbounded}
+}
+
+/*
+Returns scale policy where product is rounded with rounding mode to max decimal places of operands
+Product always has max decimal places of operands (e.g. 1.50 * 2.00 = 3.00)
+*/
+func ScaleKeepMax(mode RoundingMode) ScalePolicy {
+	return ScalePolicy{kind: scaleKeepMax, mode: mode}
+}
+
+/*
+Returns scale policy where product with more than decimals decimal places is rounded with rounding mode to decimals
+Product with fewer decimal places is not changed
+*/
+func ScaleCap(decimals int, mode RoundingMode) ScalePolicy {
+	return ScalePolicy{kind: scaleCap, decimals: decimals, mode: mode}
+}
+
+/*
+For internal use
+Returns if scale policy has valid rounding mode and non negative decimals
+*/
+func (p ScalePolicy) valid() bool {
+	return p.kind >= scaleUnbounded && p.kind <= scaleCap && p.mode.valid() && p.decimals >= 0
+}
+
+/*
+For internal use
+Applies scale policy to product where decimals is max decimal places of operands
+*/
+func (p ScalePolicy) apply(f *BigFloat, decimals int) *BigFloat {
+	if f.isSpecial() { // NaN and infinity have no decimals
+		return f
+	}
+
+	switch p.kind {
+	case scaleKeepMax:
+		f.round(decimals, p.mode, false).SetDecimals(decimals)
+	case scaleCap:
+		f.round(p.decimals, p.mode, false)
+	}
+
+	return f
+}
+
+/*
+Function type for multiplication operation.
+
+See: Mul, MulInt64
+*/
+type MulOption func(*mulOptionsType)
+
+type mulOptionsType struct {
+	scale ScalePolicy
+}
+
+/*
+Function defines scale policy of product (default is ScaleUnbounded)
+*/
+func WithMulScale(policy ScalePolicy) MulOption {
+	return func(mo *mulOptionsType) {
+		mo.scale = policy
+	}
+}
+
+/*
+Function defines scale policy of every multiplication in power operation (default is ScaleUnbounded)
+Intermediate products are rounded, so with ScaleCap result is approximate
+In Pow non-integer powers are rounded to decimal places of policy
+
+See: PowInt, Pow
+*/
+func WithPowScale(policy ScalePolicy) PowOption {
+	return func(po *powOptionsType) {
+		po.scale = policy
+	}
+}
+
+/*
+For internal use
+Returns multiplication options (panics for invalid scale policy)
+*/
+func mulOptions(options []MulOption) mulOptionsType {
+	mo := mulOptionsType{}
+	for _, option := range options {
+		option(&mo)
+	}
+
+	if !mo.scale.valid() {
+		panic("ERROR: Invalid scale policy")
+	}
+
+	return mo
+}
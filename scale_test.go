@@ -0,0 +1,194 @@
+package bigfloat
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestMulScale(t *testing.T) {
+	var cases = []struct {
+		param1   string
+		param2   string
+		policy   ScalePolicy
+		expected string
+	}{
+		{"1.0375", "1.0375", ScaleUnbounded(), "1.07640625"},
+		{"1.0375", "1.0375", ScaleKeepMax(RoundHalfUp), "1.0764"},
+		{"1.50", "2.00", ScaleKeepMax(RoundHalfUp), "3.00"},
+		{"1.25", "0.5", ScaleKeepMax(RoundHalfEven), "0.62"},
+		{"1.005", "1", ScaleKeepMax(RoundHalfUp), "1.005"},
+		{"1.23456", "1", ScaleCap(2, RoundHalfUp), "1.23"},
+		{"1.5", "1.5", ScaleCap(3, RoundHalfUp), "2.25"},
+		{"-1.25", "0.5", ScaleCap(1, RoundFloor), "-0.7"},
+		{"0", "1.234", ScaleCap(1, RoundHalfUp), "0.0"},
+		{"123.456", "789.123", ScaleCap(0, RoundDown), "97421"},
+	}
+	fmt.Printf("\nTestMulScale...\n")
+	for _, c := range cases {
+		fmt.Printf("mul(%v, %v) = ", c.param1, c.param2)
+		n1, n2, err := create2BigFloats(t, c.param1, c.param2)
+		if err != nil {
+			continue
+		}
+
+		n3 := New()
+		n3.Mul(n1, n2, WithMulScale(c.policy))
+		result := n3.String()
+
+		fmt.Printf("%v\n", result)
+		printResult(t, result, c.expected, err)
+	}
+}
+
+func TestMulInt64Scale(t *testing.T) {
+	var cases = []struct {
+		param1   string
+		param2   int64
+		policy   ScalePolicy
+		expected string
+	}{
+		{"1.0375", 3, ScaleCap(2, RoundHalfUp), "3.11"},
+		{"-800.01", 10, ScaleKeepMax(RoundHalfUp), "-8000.10"},
+		{"-800.015", 1, ScaleCap(2, RoundHalfEven), "-800.02"},
+		{"-800.01", 0, ScaleCap(1, RoundHalfUp), "0.0"},
+	}
+	fmt.Printf("\nTestMulInt64Scale...\n")
+	for _, c := range cases {
+		fmt.Printf("%v * %v = ", c.param1, c.param2)
+		n, err := createBigFloat(t, c.param1)
+		if err != nil {
+			continue
+		}
+
+		n.MulInt64(c.param2, WithMulScale(c.policy))
+		result := n.String()
+
+		fmt.Printf("%v\n", result)
+		printResult(t, result, c.expected, err)
+	}
+}
+
+func TestMulScaleChain(t *testing.T) {
+	fmt.Printf("\nTestMulScaleChain...\n")
+	n := SetInt64(1000)
+	rate, err := createBigFloat(t, "1.0375")
+	if err != nil {
+		return
+	}
+	for i := 0; i < 50; i++ {
+		n.Mul(n, rate, WithMulScale(ScaleCap(4, RoundHalfEven)))
+	}
+
+	result := n.String()
+	fmt.Printf("1000 * 1.0375^50 = %v\n", result)
+	printResult(t, result, "6300.9382", nil)
+}
+
+func TestPowIntScale(t *testing.T) {
+	var cases = []struct {
+		param1   string
+		param2   int64
+		policy   ScalePolicy
+		expected string
+	}{
+		{"1.0375", 50, ScaleCap(10, RoundHalfEven), "6.3009389109"},
+		{"1.05", 5, ScaleKeepMax(RoundHalfUp), "1.27"},
+		{"1.5", 3, ScaleUnbounded(), "3.375"},
+		{"2", 10, ScaleCap(0, RoundHalfUp), "1024"},
+	}
+	fmt.Printf("\nTestPowIntScale...\n")
+	for _, c := range cases {
+		fmt.Printf("powInt(%v, %v) = ", c.param1, c.param2)
+		n1, err := createBigFloat(t, c.param1)
+		if err != nil {
+			continue
+		}
+
+		n2 := New()
+		_, _, err = n2.PowInt(n1, c.param2, WithPowScale(c.policy))
+		result := n2.String()
+
+		fmt.Printf("%v\n", result)
+		printResult(t, result, c.expected, err)
+	}
+}
+
+func TestPowScale(t *testing.T) {
+	var cases = []struct {
+		param1   string
+		param2   string
+		policy   ScalePolicy
+		expected string
+	}{
+		{"1.05", "5", ScaleKeepMax(RoundHalfUp), "1.27"},
+		{"1.5", "3", ScaleUnbounded(), "3.375"},
+		{"2", "-1", ScaleKeepMax(RoundHalfUp), "1"},
+		{"3", "-1", ScaleCap(4, RoundHalfUp), "0.3333"},
+		{"2.00", "0.5", ScaleKeepMax(RoundDown), "1.41"},
+		{"1.0375", "2.5", ScaleCap(6, RoundHalfEven), "1.096403"},
+		{"1.0375", "2.5", ScaleCap(6, RoundCeiling), "1.096404"},
+	}
+	fmt.Printf("\nTestPowScale...\n")
+	for _, c := range cases {
+		fmt.Printf("pow(%v, %v) = ", c.param1, c.param2)
+		n1, n2, err := create2BigFloats(t, c.param1, c.param2)
+		if err != nil {
+			continue
+		}
+
+		n3, err := New().Pow(n1, n2, WithPowScale(c.policy))
+		result := ""
+		if err == nil {
+			result = n3.String()
+		}
+
+		fmt.Printf("%v\n", result)
+		printResult(t, result, c.expected, err)
+	}
+}
+
+func TestErrorsMulScale(t *testing.T) {
+	cases := []struct {
+		param1 string
+		param2 string
+		policy ScalePolicy
+	}{
+		{"1.1", "1.1", ScaleCap(-1, RoundHalfUp)},
+		{"1.1", "1.1", ScaleKeepMax(RoundingMode(-1))},
+	}
+
+	fmt.Printf("\nTestErrorsMulScale...\n")
+	for _, c := range cases {
+		fmt.Printf("mul(%v, %v) = ", c.param1, c.param2)
+		func() {
+			defer func() {
+				if err := recover(); err != nil {
+					fmt.Printf("\nOK: panic occurred: %v\n", err)
+				}
+			}()
+			n1, n2, err := create2BigFloats(nil, c.param1, c.param2)
+			if err != nil {
+				panic(err)
+			}
+			n1.Mul(n1, n2, WithMulScale(c.policy))
+			fmt.Printf("%v\n", n1.String())
+			errorStr := fmt.Sprintf("%v should raise panic", c)
+			fmt.Printf("\n" + errorStr + "\n")
+			t.Errorf(errorStr)
+		}()
+	}
+
+	fmt.Printf("powInt(2, 2) = ")
+	_, _, err := New().PowInt(SetInt64(2), 2, WithPowScale(ScaleCap(-1, RoundHalfUp)))
+	fmt.Printf("%v\n", err)
+	if err == nil {
+		t.Errorf("invalid scale policy should return error")
+	}
+
+	fmt.Printf("pow(2, 3) = ")
+	_, err = New().Pow(SetInt64(2), SetInt64(3), WithPowScale(ScaleKeepMax(RoundingMode(-1))))
+	fmt.Printf("%v\n", err)
+	if err == nil {
+		t.Errorf("invalid scale policy should return error")
+	}
+}
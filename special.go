@@ -0,0 +1,247 @@
+/*
+Copyright 2023 Tihomir Magdic. All rights reserved.
+Use of this source code is governed by a BSD-style
+license that can be found in the LICENSE file.
+*/
+
+package bigfloat
+
+import (
+	"fmt"
+	"stranalyzer"
+)
+
+/*
+Creates new BigFloat number NaN (not a number)
+*/
+func NaN() *BigFloat {
+	f := New()
+	f.analysis.Special = stranalyzer.NaN
+
+	return f
+}
+
+/*
+Creates new BigFloat number infinity with sign (+Inf for sign >= 0, -Inf for sign < 0)
+*/
+func Inf(sign int) *BigFloat {
+	f := New()
+	f.analysis.Special = stranalyzer.Inf
+	if sign < 0 {
+		f.analysis.Sign = -1
+	}
+
+	return f
+}
+
+/*
+Creates new BigFloat number negative zero
+Negative zero equals to zero, but sign is kept in operations (see Signbit)
+Negative number rounded to zero in Context is negative zero (e.g. -0.4 rounded to 0 decimals),
+while operations without Context return zero without sign (see WithParseNegZero for parsing)
+*/
+func NegZero() *BigFloat {
+	f := New()
+	f.analysis.Special = stranalyzer.NegZero
+
+	return f
+}
+
+/*
+Returns if BigFloat number is NaN
+*/
+func (f *BigFloat) IsNaN() bool {
+	return f.analysis.Special == stranalyzer.NaN
+}
+
+/*
+Returns if BigFloat number is infinity with sign (see math.IsInf):
+
+	sign > 0 - checks +Inf
+	sign < 0 - checks -Inf
+	sign == 0 - checks both
+*/
+func (f *BigFloat) IsInf(sign int) bool {
+	return f.analysis.Special == stranalyzer.Inf && (sign == 0 || (sign > 0) == (f.analysis.Sign > 0))
+}
+
+/*
+Returns if BigFloat number is finite (not NaN or infinity)
+*/
+func (f *BigFloat) IsFinite() bool {
+	return !f.isSpecial()
+}
+
+/*
+Returns if BigFloat number is negative or negative zero
+*/
+func (f *BigFloat) Signbit() bool {
+	return f.analysis.Sign < 0 || f.analysis.Special == stranalyzer.NegZero
+}
+
+/*
+For internal use
+Returns if BigFloat number is NaN or infinity
+*/
+func (f *BigFloat) isSpecial() bool {
+	return f.analysis.Special == stranalyzer.NaN || f.analysis.Special == stranalyzer.Inf
+}
+
+/*
+For internal use
+Returns zero with sign of negative flag
+*/
+func signedZero(negative bool) *BigFloat {
+	if negative {
+		return NegZero()
+	}
+
+	return New()
+}
+
+/*
+For internal use
+Returns error if any of numbers is NaN or infinity
+*/
+func checkFinite(args ...*BigFloat) error {
+	for _, a := range args {
+		if a.isSpecial() {
+			return fmt.Errorf("ERROR: Argument is not a finite number")
+		}
+	}
+
+	return nil
+}
+
+/*
+For internal use
+Calculates a + b when any operand is NaN, infinity or negative zero
+Returns false if result should be calculated as addition of finite numbers
+*/
+func addSpecial(a, b *BigFloat) (*BigFloat, bool) {
+	switch {
+	case a.IsNaN() || b.IsNaN():
+		return NaN(), true
+	case a.IsInf(0) && b.IsInf(0):
+		if a.analysis.Sign != b.analysis.Sign { // Inf - Inf
+			return NaN(), true
+		}
+		return Inf(a.analysis.Sign), true
+	case a.IsInf(0):
+		return Inf(a.analysis.Sign), true
+	case b.IsInf(0):
+		return Inf(b.analysis.Sign), true
+	case a.analysis.Special == stranalyzer.NegZero && b.analysis.Special == stranalyzer.NegZero: // -0 + -0
+		return NegZero().SetDecimals(maxInt(a.analysis.Decimals, b.analysis.Decimals)), true
+	case a.IsInt64(0) && b.IsInt64(0): // -0 + 0
+		return New().SetDecimals(maxInt(a.analysis.Decimals, b.analysis.Decimals)), true
+	}
+
+	return nil, false
+}
+
+/*
+For internal use
+Returns negated copy of BigFloat number where negated zero is negative zero (and vice versa)
+*/
+func negated(a *BigFloat) *BigFloat {
+	n := a.Copy()
+	switch {
+	case a.analysis.Special == stranalyzer.NegZero:
+		n.analysis.Special = stranalyzer.Finite
+	case a.IsInt64(0):
+		n.analysis.Special = stranalyzer.NegZero
+	default:
+		n.Neg()
+	}
+
+	return n
+}
+
+/*
+For internal use
+Calculates a * b when any operand is NaN, infinity or negative zero
+Returns false if result should be calculated as multiplication of finite numbers
+*/
+func mulSpecial(a, b *BigFloat) (*BigFloat, bool) {
+	negative := a.Signbit() != b.Signbit()
+
+	switch {
+	case a.IsNaN() || b.IsNaN():
+		return NaN(), true
+	case a.IsInf(0) || b.IsInf(0):
+		if a.IsInt64(0) || b.IsInt64(0) { // Inf * 0
+			return NaN(), true
+		}
+		if negative {
+			return Inf(-1), true
+		}
+		return Inf(1), true
+	case a.analysis.Special == stranalyzer.NegZero || b.analysis.Special == stranalyzer.NegZero:
+		return signedZero(negative).SetDecimals(maxInt(a.analysis.Decimals, b.analysis.Decimals)), true
+	}
+
+	return nil, false
+}
+
+/*
+For internal use
+Calculates a / b when any operand is NaN, infinity or negative zero, or when b is zero and infOnZero is set
+Returns false if result should be calculated as division of finite numbers
+*/
+func divSpecial(a, b *BigFloat, infOnZero bool) (*BigFloat, bool) {
+	negative := a.Signbit() != b.Signbit()
+
+	switch {
+	case a.IsNaN() || b.IsNaN():
+		return NaN(), true
+	case a.IsInf(0) && b.IsInf(0): // Inf / Inf
+		return NaN(), true
+	case a.IsInf(0):
+		if negative {
+			return Inf(-1), true
+		}
+		return Inf(1), true
+	case b.IsInf(0): // x / Inf
+		return signedZero(negative), true
+	case b.IsInt64(0) && infOnZero:
+		if a.IsInt64(0) { // 0 / 0
+			return NaN(), true
+		}
+		if negative {
+			return Inf(-1), true
+		}
+		return Inf(1), true
+	case a.analysis.Special == stranalyzer.NegZero && !b.IsInt64(0): // -0 / x
+		return signedZero(negative), true
+	}
+
+	return nil, false
+}
+
+/*
+For internal use
+Compares numbers when any of numbers is NaN or infinity (see Compare)
+*/
+func compareSpecial(a, b *BigFloat, abs bool) int {
+	rank := func(x *BigFloat) int {
+		switch {
+		case x.IsNaN():
+			return 2
+		case x.IsInf(1) || (abs && x.IsInf(0)):
+			return 1
+		case x.IsInf(-1):
+			return -1
+		}
+		return 0
+	}
+
+	ra, rb := rank(a), rank(b)
+	if ra < rb {
+		return -1
+	} else if ra > rb {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,330 @@
+package bigfloat
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSpecialString(t *testing.T) {
+	var cases = []struct {
+		param    string
+		options  []StringOption
+		expected string
+	}{
+		{"NaN", nil, "NaN"},
+		{"-nan", nil, "NaN"},
+		{"Inf", nil, "Inf"},
+		{"+Infinity", []StringOption{ForceSign(true)}, "+Inf"},
+		{"-inf", nil, "-Inf"},
+		{"-0", nil, "0"},
+		{"-0", []StringOption{SignedZero(true)}, "-0"},
+		{"-0.00", []StringOption{SignedZero(true)}, "-0.00"},
+		{"0.00", []StringOption{SignedZero(true)}, "0.00"},
+	}
+	fmt.Printf("\nTestSpecialString...\n")
+	for _, c := range cases {
+		fmt.Printf("string(%v) = ", c.param)
+		n1, err := createBigFloat(t, c.param, WithParseNegZero(true))
+		if err != nil {
+			continue
+		}
+
+		result := n1.StringWith(c.options...)
+		fmt.Printf("%v\n", result)
+		printResult(t, result, c.expected, nil)
+	}
+}
+
+func TestSpecialStringF(t *testing.T) {
+	var cases = []struct {
+		param    *BigFloat
+		expected string
+	}{
+		{NaN(), "NaN"},
+		{Inf(1), "+Inf"},
+		{Inf(-1), "-Inf"},
+	}
+	fmt.Printf("\nTestSpecialStringF...\n")
+	for _, c := range cases {
+		fmt.Printf("stringF(%v, 2) = ", c.param)
+		result := c.param.StringF(2, ForceSign(true))
+		fmt.Printf("%v\n", result)
+		printResult(t, result, c.expected, nil)
+	}
+}
+
+func TestSpecialOperations(t *testing.T) {
+	var cases = []struct {
+		op       string
+		param1   string
+		param2   string
+		expected string
+	}{
+		{"add", "Inf", "1", "Inf"},
+		{"add", "Inf", "-Inf", "NaN"},
+		{"add", "-Inf", "-Inf", "-Inf"},
+		{"add", "NaN", "1", "NaN"},
+		{"add", "-0", "-0.0", "-0.0"},
+		{"add", "-0", "0", "0"},
+		{"add", "-0", "5", "5"},
+		{"sub", "Inf", "Inf", "NaN"},
+		{"sub", "Inf", "-Inf", "Inf"},
+		{"sub", "1", "Inf", "-Inf"},
+		{"sub", "-0", "0", "-0"},
+		{"sub", "0", "-0", "0"},
+		{"sub", "-0", "-0", "0"},
+		{"sub", "-0", "1.5", "-1.5"},
+		{"mul", "Inf", "0", "NaN"},
+		{"mul", "-Inf", "2", "-Inf"},
+		{"mul", "-Inf", "-2", "Inf"},
+		{"mul", "-0", "5", "-0"},
+		{"mul", "-0", "-5", "0"},
+		{"mul", "-0", "Inf", "NaN"},
+		{"mul", "0", "-5", "0"},
+		{"mul", "NaN", "0", "NaN"},
+		{"mulint", "Inf", "0", "NaN"},
+		{"mulint", "Inf", "-1", "-Inf"},
+		{"mulint", "-Inf", "3", "-Inf"},
+		{"mulint", "NaN", "1", "NaN"},
+		{"mulint", "-0", "-2", "0"},
+		{"mulint", "-0.00", "5", "-0.00"},
+		{"div", "1", "Inf", "0"},
+		{"div", "-1", "Inf", "-0"},
+		{"div", "Inf", "-2", "-Inf"},
+		{"div", "Inf", "Inf", "NaN"},
+		{"div", "-0", "5", "-0"},
+		{"div", "-0", "-5", "0"},
+		{"div", "NaN", "0", "NaN"},
+		{"round", "-0.4", "0", "0"},
+		{"round", "-0.04", "1", "0.0"},
+		{"round", "-0.6", "0", "-1"},
+		{"round", "0.4", "0", "0"},
+	}
+	fmt.Printf("\nTestSpecialOperations...\n")
+	for _, c := range cases {
+		fmt.Printf("%v(%v, %v) = ", c.op, c.param1, c.param2)
+		n1, n2, err := create2BigFloats(t, c.param1, c.param2, WithParseNegZero(true))
+		if err != nil {
+			continue
+		}
+
+		n3 := New()
+		switch c.op {
+		case "add":
+			n3.Add(n1, n2)
+		case "sub":
+			n3.Sub(n1, n2)
+		case "mul":
+			n3.Mul(n1, n2)
+		case "mulint": // 2nd parameter is int64
+			d, _ := n2.int64Part()
+			n3 = n1.MulInt64(d)
+		case "div":
+			_, _, err = n3.Div(n1, n2)
+		case "round": // 2nd parameter is number of decimals
+			d, _ := n2.int64Part()
+			n3 = n1.Round(int(d))
+		}
+
+		result := n3.StringWith(SignedZero(true))
+		fmt.Printf("%v\n", result)
+		printResult(t, result, c.expected, err)
+	}
+}
+
+func TestDivInfOnZero(t *testing.T) {
+	var cases = []struct {
+		param1   string
+		param2   string
+		expected string
+	}{
+		{"1", "0", "Inf"},
+		{"-1", "0.00", "-Inf"},
+		{"1", "-0", "-Inf"},
+		{"-1", "-0", "Inf"},
+		{"0", "0", "NaN"},
+		{"1", "4", "0.25"},
+	}
+	fmt.Printf("\nTestDivInfOnZero...\n")
+	for _, c := range cases {
+		fmt.Printf("div(%v, %v) = ", c.param1, c.param2)
+		n1, n2, err := create2BigFloats(t, c.param1, c.param2, WithParseNegZero(true))
+		if err != nil {
+			continue
+		}
+
+		n3 := New()
+		_, _, err = n3.Div(n1, n2, WithDivInfOnZero(true))
+
+		result := n3.String()
+		fmt.Printf("%v\n", result)
+		printResult(t, result, c.expected, err)
+	}
+}
+
+func TestSpecialCompare(t *testing.T) {
+	var cases = []struct {
+		param1   string
+		param2   string
+		expected int
+		abs      int
+	}{
+		{"NaN", "NaN", 0, 0},
+		{"NaN", "Inf", 1, 1},
+		{"-Inf", "NaN", -1, -1},
+		{"Inf", "1e100", 1, 1},
+		{"-Inf", "-1e100", -1, 1},
+		{"-Inf", "Inf", -1, 0},
+		{"-0", "0", 0, 0},
+		{"-0.00", "-0.1", 1, -1},
+	}
+	fmt.Printf("\nTestSpecialCompare...\n")
+	for _, c := range cases {
+		fmt.Printf("compare(%v, %v) = ", c.param1, c.param2)
+		n1, n2, err := create2BigFloats(t, c.param1, c.param2, WithParseNegZero(true))
+		if err != nil {
+			continue
+		}
+
+		expectedStr := fmt.Sprintf("%v, %v", c.expected, c.abs)
+		result := fmt.Sprintf("%v, %v", n1.Compare(n2), n1.CompareAbs(n2))
+
+		fmt.Printf("%v\n", result)
+		printResult(t, result, expectedStr, err)
+	}
+}
+
+func TestSpecialPredicates(t *testing.T) {
+	var cases = []struct {
+		param    string
+		expected string
+	}{
+		{"NaN", "nan: true, inf: false, +inf: false, -inf: false, finite: false, signbit: false"},
+		{"Inf", "nan: false, inf: true, +inf: true, -inf: false, finite: false, signbit: false"},
+		{"-Inf", "nan: false, inf: true, +inf: false, -inf: true, finite: false, signbit: true"},
+		{"-0", "nan: false, inf: false, +inf: false, -inf: false, finite: true, signbit: true"},
+		{"0", "nan: false, inf: false, +inf: false, -inf: false, finite: true, signbit: false"},
+		{"-2.5", "nan: false, inf: false, +inf: false, -inf: false, finite: true, signbit: true"},
+	}
+	fmt.Printf("\nTestSpecialPredicates...\n")
+	for _, c := range cases {
+		fmt.Printf("%v: ", c.param)
+		n1, err := createBigFloat(t, c.param, WithParseNegZero(true))
+		if err != nil {
+			continue
+		}
+
+		result := fmt.Sprintf("nan: %v, inf: %v, +inf: %v, -inf: %v, finite: %v, signbit: %v",
+			n1.IsNaN(), n1.IsInf(0), n1.IsInf(1), n1.IsInf(-1), n1.IsFinite(), n1.Signbit())
+		fmt.Printf("%v\n", result)
+		printResult(t, result, c.expected, nil)
+	}
+}
+
+func TestUnsignedZeroResults(t *testing.T) {
+	minusOne, x := SetInt64(-1), SetInt64(3)
+	var cases = []struct {
+		name     string
+		fn       func() (*BigFloat, error)
+		expected string
+	}{
+		{"round(-0.4, 0)", func() (*BigFloat, error) { r, err := SetString("-0.4"); return r.Round(0), err }, "0, false"},
+		{"divmod(-1, 3)", func() (*BigFloat, error) { q, _, err := New().DivMod(minusOne, x); return q, err }, "0, false"},
+		{"div(-1, 3, 0)", func() (*BigFloat, error) {
+			q, _, err := New().Div(minusOne, x, WithDivDecimalPlaces(0))
+			return q, err
+		}, "0, false"},
+		{"atan2(round(-0.4, 0), -1)", func() (*BigFloat, error) {
+			y, _ := SetString("-0.4")
+			return New().Atan2(y.Round(0), minusOne, WithDecimalPlaces(5))
+		}, "3.14159, false"},
+	}
+	fmt.Printf("\nTestUnsignedZeroResults...\n")
+	for _, c := range cases {
+		fmt.Printf("%v = ", c.name)
+		r, err := c.fn()
+		if err != nil {
+			printResult(t, "", c.expected, err)
+			continue
+		}
+
+		result := fmt.Sprintf("%v, %v", r.StringWith(SignedZero(true)), r.Signbit())
+		fmt.Printf("%v\n", result)
+		printResult(t, result, c.expected, nil)
+	}
+}
+
+func TestParseNegZero(t *testing.T) {
+	var cases = []struct {
+		param    string
+		negZero  bool
+		expected string
+	}{
+		{"-0", false, "0, false"},
+		{"-0.00", false, "0.00, false"},
+		{"-0", true, "-0, true"},
+		{"-0.00", true, "-0.00, true"},
+		{"0", true, "0, false"},
+	}
+	fmt.Printf("\nTestParseNegZero...\n")
+	for _, c := range cases {
+		fmt.Printf("parse(%v, negZero: %v) = ", c.param, c.negZero)
+		n1, err := SetString(c.param, WithParseNegZero(c.negZero))
+
+		result := fmt.Sprintf("%v, %v", n1.StringWith(SignedZero(true)), n1.Signbit())
+		fmt.Printf("%v\n", result)
+		printResult(t, result, c.expected, err)
+	}
+}
+
+func TestSpecialConstructors(t *testing.T) {
+	fmt.Printf("\nTestSpecialConstructors...\n")
+	result := fmt.Sprintf("%v %v %v %v", NaN(), Inf(1), Inf(-1), NegZero().StringWith(SignedZero(true)))
+	fmt.Printf("%v\n", result)
+	printResult(t, result, "NaN Inf -Inf -0", nil)
+
+	n := NegZero().Neg()
+	result = n.StringWith(SignedZero(true))
+	fmt.Printf("neg(-0) = %v\n", result)
+	printResult(t, result, "0", nil)
+
+	n = Inf(-1).Abs()
+	result = n.String()
+	fmt.Printf("abs(-Inf) = %v\n", result)
+	printResult(t, result, "Inf", nil)
+}
+
+func TestErrorsSpecial(t *testing.T) {
+	var cases = []struct {
+		name string
+		fn   func() error
+	}{
+		{"sqrt(NaN)", func() error { _, _, err := New().Sqrt(NaN()); return err }},
+		{"sin(Inf)", func() error { _, err := New().Sin(Inf(1)); return err }},
+		{"atan2(1, -Inf)", func() error { _, err := New().Atan2(SetInt64(1), Inf(-1)); return err }},
+		{"exp(-Inf)", func() error { _, err := New().Exp(Inf(-1), 10); return err }},
+		{"pow(NaN, 2)", func() error { _, err := New().Pow(NaN(), SetInt64(2)); return err }},
+		{"divmod(Inf, 2)", func() error { _, _, err := New().DivMod(Inf(1), SetInt64(2)); return err }},
+		{"div(1, -0)", func() error { _, _, err := New().Div(SetInt64(1), NegZero()); return err }},
+	}
+
+	fmt.Printf("\nTestErrorsSpecial...\n")
+	for _, c := range cases {
+		fmt.Printf("%v = ", c.name)
+		func() {
+			defer func() {
+				if err := recover(); err != nil {
+					fmt.Printf("\nOK: panic occurred: %v\n", err)
+				}
+			}()
+
+			if err := c.fn(); err != nil {
+				panic(err)
+			}
+
+			errorStr := fmt.Sprintf("%v should raise panic", c.name)
+			fmt.Printf("\n" + errorStr + "\n")
+			t.Errorf(errorStr)
+		}()
+	}
+}
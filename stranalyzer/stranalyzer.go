@@ -4,21 +4,107 @@ import (
 	"bytes"
 	"fmt"
 	"strconv"
+	"strings"
 	"unicode"
 )
 
+const (
+	Finite  = iota // finite number
+	NegZero        // zero with negative sign (Norm is zero and Sign is 1)
+	NaN            // not a number (Norm is zero)
+	Inf            // infinity with Sign (Norm is zero)
+)
+
+/*
+Maximum number of zeroes added to digits when decimal point is moved (E notation, Shift)
+Number which needs more zeroes is kept in compact form as coefficient Norm and Exponent
+*/
+const MaxPadding = 1000
+
+/*
+Analyzed number is Norm with Decimals decimal places multiplied by 10^Exponent
+Exponent is 0 except for compact form, where Norm is integer coefficient without leading and trailing zeroes and Decimals is 0
+*/
 type Analysis struct {
 	Norm     []byte
 	Sign     int
 	Decimals int
 	Len      int
+	Special  int
+	Exponent int
+}
+
+/*
+Analyzes special values "NaN", "Inf" and "Infinity" (case insensitive, with optional sign)
+Returns false if s is not a special value
+*/
+func analyzeSpecial(s string) (Analysis, bool) {
+	word := strings.ToLower(strings.Map(func(c rune) rune {
+		if !visible(c) || c == ' ' {
+			return -1
+		}
+		return c
+	}, s))
+
+	a := Analysis{Norm: []byte{'0'}, Sign: 1, Len: 1}
+	if strings.HasPrefix(word, "+") {
+		word = word[1:]
+	} else if strings.HasPrefix(word, "-") {
+		word = word[1:]
+		a.Sign = -1
+	}
+
+	switch word {
+	case "nan":
+		a.Special = NaN
+		a.Sign = 1
+	case "inf", "infinity":
+		a.Special = Inf
+	default:
+		return a, false
+	}
+
+	return a, true
 }
 
 func visible(c rune) bool {
 	return unicode.IsGraphic(c)
 }
 
-func Analyze(s string) (a Analysis, e error) {
+/*
+Function type for analyzing options
+
+See: Analyze
+*/
+type Option func(*options)
+
+type options struct {
+	negZero bool
+}
+
+/*
+Function defines if zero with '-' sign (e.g. "-0.00") is analyzed as NegZero (default is zero without sign)
+*/
+func WithNegZero(negZero bool) Option {
+	return func(o *options) {
+		o.negZero = negZero
+	}
+}
+
+/*
+Analyzes number with optional sign, decimal point and E notation, or special value NaN and infinity
+Zero with '-' sign is NegZero only with WithNegZero option
+*/
+func Analyze(s string, opts ...Option) (a Analysis, e error) {
+	o := options{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if special, ok := analyzeSpecial(s); ok {
+		return special, nil
+	}
+
 	r := []rune(s)
 	signFound := false
 	a.Sign = 1
@@ -119,31 +205,105 @@ func Analyze(s string) (a Analysis, e error) {
 	a.Norm = normBuf
 	if !nonZeroDigitFound && (a.Sign != 1) {
 		a.Sign = 1
+		if o.negZero {
+			a.Special = NegZero
+		}
 	}
 	if eFound {
-		eIntParsed, _ := strconv.ParseInt(eValue, 10, 0)
-		eInt := int(eIntParsed)
-		if eSign == 1 {
-			a.Decimals -= eInt
-			if a.Decimals < 0 {
-				eInt = -a.Decimals
-				a.Decimals = 0
-			} else {
-				eInt -= a.Decimals
-			}
-			//a.Norm += strings.Repeat("0", int(eInt))
-			//append([]byte("0"), int(eInt))
-			a.Norm = append(a.Norm, bytes.Repeat([]byte("0"), int(eInt))...)
-			a.Len += eInt
-		} else {
-			a.Decimals += eInt
-			if a.Decimals >= a.Len {
-				eInt = a.Decimals - a.Len + 1
-				//a.Norm = strings.Repeat("0", int(eInt)) + a.Norm
-				a.Norm = append(bytes.Repeat([]byte("0"), int(eInt)), a.Norm...)
-				a.Len += eInt
-			}
+		eInt, err := strconv.Atoi(eValue)
+		if err != nil {
+			return a, fmt.Errorf("ERROR: E number out of range")
 		}
+		a = Shift(a, eSign*eInt)
 	}
 	return a, nil
 }
+
+func isZero(norm []byte) bool {
+	for _, d := range norm {
+		if d != '0' {
+			return false
+		}
+	}
+
+	return true
+}
+
+/*
+Moves decimal point of analyzed number n places (multiplication with 10^n, n can be negative)
+Leading zeroes of whole number part are removed, trailing zeroes are kept (e.g. 1.50 shifted by -1 is 0.150)
+Non zero number with more than MaxPadding zeroes before first or after last significant digit is returned in compact form
+*/
+func Shift(a Analysis, n int) Analysis {
+	if a.Special == NaN || a.Special == Inf {
+		return a
+	}
+
+	norm := a.Norm
+	decimals := a.Decimals - a.Exponent - n // negative for zeroes after last digit
+	if isZero(norm) {
+		norm = []byte{'0'}
+		decimals = max(decimals, 0) // whole number zeroes are not needed
+	}
+
+	coefficient := bytes.TrimLeft(norm, "0")
+	trimmed := bytes.TrimRight(coefficient, "0")
+	zeroes := 0 // zeroes of whole number after last non zero digit or zeroes before first non zero decimal
+	if decimals <= 0 {
+		zeroes = len(coefficient) - len(trimmed) - decimals
+	} else if decimals >= len(coefficient) {
+		zeroes = decimals - len(coefficient) + 1
+	}
+
+	r := Analysis{Sign: a.Sign, Special: a.Special}
+	if zeroes > MaxPadding && len(trimmed) > 0 { // compact form
+		r.Norm = append([]byte(nil), trimmed...)
+		r.Len = len(r.Norm)
+		r.Exponent = len(coefficient) - len(trimmed) - decimals
+
+		return r
+	}
+
+	switch {
+	case decimals < 0:
+		r.Norm = append(append(make([]byte, 0, len(norm)-decimals), norm...), bytes.Repeat([]byte("0"), -decimals)...)
+		decimals = 0
+	case decimals >= len(norm):
+		r.Norm = append(bytes.Repeat([]byte("0"), decimals-len(norm)+1), norm...)
+	default:
+		r.Norm = append([]byte(nil), norm...)
+	}
+
+	iTrim := 0 // leading zeroes except first digit before decimal point
+	for iTrim < len(r.Norm)-decimals-1 && r.Norm[iTrim] == '0' {
+		iTrim++
+	}
+	r.Norm = r.Norm[iTrim:]
+	r.Len = len(r.Norm)
+	r.Decimals = decimals
+
+	return r
+}
+
+/*
+Returns analyzed number with all digits (compact form is expanded regardless of MaxPadding)
+*/
+func Expand(a Analysis) Analysis {
+	if a.Exponent == 0 {
+		return a
+	}
+
+	r := a
+	r.Exponent = 0
+	if a.Exponent > 0 {
+		r.Norm = append(append(make([]byte, 0, a.Len+a.Exponent), a.Norm...), bytes.Repeat([]byte("0"), a.Exponent)...)
+		r.Decimals = 0
+	} else {
+		r.Decimals = a.Decimals - a.Exponent
+		padding := max(r.Decimals-a.Len+1, 0)
+		r.Norm = append(bytes.Repeat([]byte("0"), padding), a.Norm...)
+	}
+	r.Len = len(r.Norm)
+
+	return r
+}
@@ -2,6 +2,7 @@ package stranalyzer
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 )
 
@@ -53,6 +54,14 @@ func TestAnalyze(t *testing.T) {
 		{"1.5e-2", false},
 		{"1.5e+3", false},
 		{"1.52e+1", false},
+		{"NaN", false},
+		{"-inf", false},
+		{" + Infinity", false},
+		{"nan1", true},
+		{"infinit", true},
+		{"1e1000000", false},
+		{"-1.5e-1000000", false},
+		{"1e99999999999999999999", true},
 	}
 	for _, c := range cases {
 		a, error := Analyze(c.in)
@@ -75,3 +84,98 @@ func TestAnalyze(t *testing.T) {
 		}
 	}
 }
+
+func TestAnalyzeSpecial(t *testing.T) {
+	cases := []struct {
+		in      string
+		negZero bool
+		special int
+		sign    int
+	}{
+		{"NaN", false, NaN, 1},
+		{"-nan", false, NaN, 1},
+		{"Inf", false, Inf, 1},
+		{"+inf", false, Inf, 1},
+		{"-Infinity", false, Inf, -1},
+		{"-0", false, Finite, 1},
+		{"-0", true, NegZero, 1},
+		{"-0.00", true, NegZero, 1},
+		{"0", true, Finite, 1},
+		{"-1", true, Finite, -1},
+	}
+	for _, c := range cases {
+		a, error := Analyze(c.in, WithNegZero(c.negZero))
+		if error != nil {
+			t.Errorf("Analyze: %q", error)
+			continue
+		}
+		fmt.Printf("%q (negZero: %v): special: %d, sign: %d\n", c.in, c.negZero, a.Special, a.Sign)
+		if a.Special != c.special || a.Sign != c.sign {
+			t.Errorf("Analyze: wrong special value for %q", c.in)
+		}
+	}
+}
+
+func TestExponent(t *testing.T) {
+	cases := []struct {
+		in       string
+		norm     string
+		decimals int
+		exponent int
+	}{
+		{"1.5e1", "15", 0, 0},
+		{"0.5e1", "5", 0, 0},
+		{"1.50e1", "150", 1, 0},
+		{"1e-3", "0001", 3, 0},
+		{"1e1000", "1" + strings.Repeat("0", 1000), 0, 0},
+		{"1e1001", "1", 0, 1001},
+		{"1e1000000", "1", 0, 1000000},
+		{"1.5e-1000000", "15", 0, -1000001},
+		{"1200e2000", "12", 0, 2002},
+		{"0.0025e-2000", "25", 0, -2004},
+	}
+	for _, c := range cases {
+		a, error := Analyze(c.in)
+		if error != nil {
+			t.Errorf("Analyze: %q", error)
+			continue
+		}
+		fmt.Printf("%q: len: %d, decimals: %d, exponent: %d\n", c.in, a.Len, a.Decimals, a.Exponent)
+		if string(a.Norm) != c.norm || a.Len != len(a.Norm) || a.Decimals != c.decimals || a.Exponent != c.exponent {
+			t.Errorf("Analyze: wrong coefficient or exponent for %q", c.in)
+		}
+	}
+}
+
+func TestShift(t *testing.T) {
+	cases := []struct {
+		in       string
+		n        int
+		expected string
+	}{
+		{"1.50", -1, "0150 3 0"},
+		{"1.50", 2, "150 0 0"},
+		{"0.00", 5, "0 0 0"},
+		{"1", -1001, "1 0 -1001"},
+		{"1", 1001, "1 0 1001"},
+		{"1e1001", -1001, "1 0 0"},
+		{"1e-1000000", 999999, "01 1 0"},
+		{"250", 2000, "25 0 2001"},
+	}
+	for _, c := range cases {
+		a, error := Analyze(c.in)
+		if error != nil {
+			t.Errorf("Analyze: %q", error)
+			continue
+		}
+		r := Shift(a, c.n)
+		result := fmt.Sprintf("%s %d %d", r.Norm, r.Decimals, r.Exponent)
+		fmt.Printf("shift(%q, %d): %s\n", c.in, c.n, result)
+		if result != c.expected || r.Len != len(r.Norm) {
+			t.Errorf("Shift: %q should be %q", result, c.expected)
+		}
+		if e := Expand(r); e.Exponent != 0 || e.Len != len(e.Norm) {
+			t.Errorf("Expand: wrong expanded form of %q", result)
+		}
+	}
+}
@@ -0,0 +1,392 @@
+/*
+Copyright 2023 Tihomir Magdic. All rights reserved.
+Use of this source code is governed by a BSD-style
+license that can be found in the LICENSE file.
+*/
+
+package bigfloat
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+For internal use
+Calculates atan(z) = z - z^3/3 + z^5/5 - ... for |z| < 1 rounded to n decimals in every step
+*/
+func atanSeries(z *BigFloat, n int) *BigFloat {
+	z2 := mulWP(z, z, n)
+	p := z.Copy()
+	sum := z.Copy()
+	for k := int64(3); ; k += 2 {
+		p = mulWP(p, z2, n).Neg()
+		term := divWP(p, SetInt64(k), n)
+		if term.IsInt64(0) {
+			break
+		}
+		sum = New().Add(sum, term)
+	}
+
+	return sum
+}
+
+/*
+For internal use
+Calculates sin(r) = r - r^3/3! + r^5/5! - ... rounded to n decimals in every step
+*/
+func sinSeries(r *BigFloat, n int) *BigFloat {
+	r2 := mulWP(r, r, n)
+	term := r.Copy()
+	sum := r.Copy()
+	for k := int64(2); ; k += 2 {
+		term = divWP(mulWP(term, r2, n), SetInt64(k*(k+1)), n).Neg()
+		if term.IsInt64(0) {
+			break
+		}
+		sum = New().Add(sum, term)
+	}
+
+	return sum
+}
+
+/*
+For internal use
+Calculates cos(r) = 1 - r^2/2! + r^4/4! - ... rounded to n decimals in every step
+*/
+func cosSeries(r *BigFloat, n int) *BigFloat {
+	r2 := mulWP(r, r, n)
+	term := SetInt64(1)
+	sum := SetInt64(1)
+	for k := int64(1); ; k += 2 {
+		term = divWP(mulWP(term, r2, n), SetInt64(k*(k+1)), n).Neg()
+		if term.IsInt64(0) {
+			break
+		}
+		sum = New().Add(sum, term)
+	}
+
+	return sum
+}
+
+/*
+For internal use
+Reduces argument as x = r + k * pi/2 with |r| <= pi/4 (rounded to n decimals)
+Returns r and quadrant k mod 4
+Pi is calculated with additional digits for every whole number digit of x, so reduction is correct for large arguments
+*/
+func reduceHalfPi(x *BigFloat, n int) (*BigFloat, int) {
+	intDigits := maxInt(x.exponent(), 0)
+	halfPi := pi(n + intDigits + 1)
+	halfPi = divWP(halfPi, SetInt64(2), n+intDigits+guardDigits)
+
+	k := divWP(x, halfPi, 1).Round(0) // nearest integer of x / (pi/2)
+	if k.IsInt64(0) {
+		return x.Copy(), 0
+	}
+
+	r := New().Sub(x, New().Mul(k, halfPi)).roundTo(n)
+
+	digits := k.analysis.Norm[:k.analysis.Len-k.analysis.Decimals] // k mod 4 depends on last 2 digits
+	if len(digits) > 2 {
+		digits = digits[len(digits)-2:]
+	}
+	m, _ := strconv.Atoi(string(digits))
+	quadrant := m % 4
+	if k.GetSign() < 0 {
+		quadrant = (4 - quadrant) % 4
+	}
+
+	return r, quadrant
+}
+
+/*
+For internal use
+Calculates sin(x) and cos(x) with absolute error less then 10^-decimals
+*/
+func sinCos(x *BigFloat, decimals int) (*BigFloat, *BigFloat) {
+	wp := decimals + guardDigits
+	r, quadrant := reduceHalfPi(x, wp)
+	s, c := sinSeries(r, wp), cosSeries(r, wp)
+
+	switch quadrant {
+	case 1: // sin(r + pi/2) = cos(r), cos(r + pi/2) = -sin(r)
+		s, c = c, s.Neg()
+	case 2: // sin(r + pi) = -sin(r), cos(r + pi) = -cos(r)
+		s, c = s.Neg(), c.Neg()
+	case 3: // sin(r + 3pi/2) = -cos(r), cos(r + 3pi/2) = sin(r)
+		s, c = c.Neg(), s
+	}
+
+	return s, c
+}
+
+/*
+Calculates sine of BigFloat number (radians) with RoundOption:
+
+	decimalPlaces - target decimal places (default is 16)
+	roundingMode - rounding mode of result (default is RoundHalfUp)
+
+Argument is reduced with high-precision pi, so result is correct for large arguments too.
+*/
+func (f *BigFloat) Sin(x *BigFloat, options ...RoundOption) (*BigFloat, error) {
+	ro, err := mathOptions(options, x)
+	if err != nil {
+		return nil, err
+	}
+
+	r, _ := roundResult(ro, func(decimals int) (*BigFloat, error) {
+		s, _ := sinCos(x, decimals)
+
+		return s, nil
+	})
+	f.analysis = r.analysis
+
+	return f, nil
+}
+
+/*
+Calculates cosine of BigFloat number (radians) with RoundOption:
+
+	decimalPlaces - target decimal places (default is 16)
+	roundingMode - rounding mode of result (default is RoundHalfUp)
+
+Argument is reduced with high-precision pi, so result is correct for large arguments too.
+*/
+func (f *BigFloat) Cos(x *BigFloat, options ...RoundOption) (*BigFloat, error) {
+	ro, err := mathOptions(options, x)
+	if err != nil {
+		return nil, err
+	}
+
+	r, _ := roundResult(ro, func(decimals int) (*BigFloat, error) {
+		_, c := sinCos(x, decimals)
+
+		return c, nil
+	})
+	f.analysis = r.analysis
+
+	return f, nil
+}
+
+/*
+Calculates tangent of BigFloat number (radians) with RoundOption:
+
+	decimalPlaces - target decimal places (default is 16)
+	roundingMode - rounding mode of result (default is RoundHalfUp)
+
+Decimal number is never a pole of tangent, so working precision is increased until cosine is not 0
+(e.g. tan(1.570796326794896619231) = 3108566951794764943058.71 with 2 decimal places).
+*/
+func (f *BigFloat) Tan(x *BigFloat, options ...RoundOption) (*BigFloat, error) {
+	ro, err := mathOptions(options, x)
+	if err != nil {
+		return nil, err
+	}
+
+	r, _ := roundResult(ro, func(decimals int) (*BigFloat, error) {
+		wp := decimals
+		s, c := sinCos(x, wp)
+		for c.IsInt64(0) { // argument is very close to pole
+			wp *= 2
+			s, c = sinCos(x, wp)
+		}
+		if z := -c.exponent(); z > 0 { // small cosine increases error of quotient
+			wp += 2 * z
+			s, c = sinCos(x, wp)
+		}
+
+		return divWP(s, c, wp), nil
+	})
+	f.analysis = r.analysis
+
+	return f, nil
+}
+
+/*
+For internal use
+Calculates atan(x) with absolute error less then 10^-decimals
+
+For |x| > 1 atan(x) = sign(x) * pi/2 - atan(1/x), then argument is halved with atan(x) = 2 * atan(x / (1 + sqrt(1 + x^2))) until |x| <= 0.1
+*/
+func atan(x *BigFloat, decimals int) *BigFloat {
+	wp := decimals + guardDigits
+	if x.IsInt64(0) {
+		return New()
+	}
+
+	one := SetInt64(1)
+	if x.CompareAbs(one) > 0 {
+		halfPi := divWP(pi(wp), SetInt64(2), wp).Sign(x.GetSign())
+
+		return New().Sub(halfPi, atan(divWP(one, x, wp), wp))
+	}
+
+	threshold, _ := SetString("0.1")
+	z := x.Copy()
+	halvings := 0
+	for z.CompareAbs(threshold) > 0 {
+		s, _, _ := New().Sqrt(New().Add(one, New().Mul(z, z)), WithDecimalPlaces(wp))
+		z = divWP(z, New().Add(one, s), wp)
+		halvings++
+	}
+
+	return atanSeries(z, wp).MulInt64(1 << halvings)
+}
+
+/*
+For internal use
+Calculates atan2(y, x) with absolute error less then 10^-decimals
+*/
+func atan2(y, x *BigFloat, decimals int) *BigFloat {
+	wp := decimals + guardDigits
+
+	if y.IsInt64(0) {
+		if !x.Signbit() { // atan2(+-0, x>=+0) = +-0
+			return signedZero(y.Signbit())
+		}
+		if y.Signbit() { // atan2(-0, x<=-0) = -pi
+			return pi(wp).Sign(-1)
+		}
+
+		return pi(wp) // atan2(0, x<=-0) = pi
+	}
+
+	if x.IsInt64(0) {
+		return divWP(pi(wp), SetInt64(2), wp).Sign(y.GetSign()) // atan2(+-y, +-0) = +-pi/2
+	}
+
+	r := atan(divWP(y, x, wp), wp)
+	if x.GetSign() < 0 { // 2nd and 3rd quadrant
+		p := pi(wp).Sign(y.GetSign())
+		r = New().Add(r, p)
+	}
+
+	return r
+}
+
+/*
+For internal use
+Checks argument of arcsine and arccosine
+*/
+func checkAsinArgument(x *BigFloat) error {
+	if x.CompareAbs(SetInt64(1)) > 0 {
+		return fmt.Errorf("ERROR: Argument is out of range [-1, 1]")
+	}
+
+	return nil
+}
+
+/*
+For internal use
+Calculates asin(x) = atan2(x, sqrt(1 - x^2)) with absolute error less then 10^-decimals
+*/
+func asin(x *BigFloat, decimals int) *BigFloat {
+	wp := decimals + guardDigits
+	s, _, _ := New().Sqrt(New().Sub(SetInt64(1), New().Mul(x, x)), WithDecimalPlaces(wp))
+
+	return atan2(x, s, wp)
+}
+
+/*
+Calculates arctangent of BigFloat number with RoundOption:
+
+	decimalPlaces - target decimal places (default is 16)
+	roundingMode - rounding mode of result (default is RoundHalfUp)
+
+Result is in range [-pi/2, pi/2].
+*/
+func (f *BigFloat) Atan(x *BigFloat, options ...RoundOption) (*BigFloat, error) {
+	ro, err := mathOptions(options, x)
+	if err != nil {
+		return nil, err
+	}
+
+	r, _ := roundResult(ro, func(decimals int) (*BigFloat, error) {
+		return atan(x, decimals), nil
+	})
+	f.analysis = r.analysis
+
+	return f, nil
+}
+
+/*
+Calculates arctangent of y/x using signs of both numbers to determine quadrant (see math.Atan2) with RoundOption:
+
+	decimalPlaces - target decimal places (default is 16)
+	roundingMode - rounding mode of result (default is RoundHalfUp)
+
+Result is in range [-pi, pi]. Sign of zero is used like in math.Atan2. Special cases are:
+
+	atan2(+-0, x>=+0) = +-0
+	atan2(+-0, x<=-0) = +-pi
+	atan2(y>0, +-0) = pi/2
+	atan2(y<0, +-0) = -pi/2
+*/
+func (f *BigFloat) Atan2(y, x *BigFloat, options ...RoundOption) (*BigFloat, error) {
+	ro, err := mathOptions(options, y, x)
+	if err != nil {
+		return nil, err
+	}
+
+	r, _ := roundResult(ro, func(decimals int) (*BigFloat, error) {
+		return atan2(y, x, decimals), nil
+	})
+	f.analysis = r.analysis
+
+	return f, nil
+}
+
+/*
+Calculates arcsine of BigFloat number with RoundOption:
+
+	decimalPlaces - target decimal places (default is 16)
+	roundingMode - rounding mode of result (default is RoundHalfUp)
+
+Result is in range [-pi/2, pi/2]. Returns error for argument out of range [-1, 1].
+*/
+func (f *BigFloat) Asin(x *BigFloat, options ...RoundOption) (*BigFloat, error) {
+	ro, err := mathOptions(options, x)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkAsinArgument(x); err != nil {
+		return nil, err
+	}
+
+	r, _ := roundResult(ro, func(decimals int) (*BigFloat, error) {
+		return asin(x, decimals), nil
+	})
+	f.analysis = r.analysis
+
+	return f, nil
+}
+
+/*
+Calculates arccosine of BigFloat number as acos(x) = pi/2 - asin(x) with RoundOption:
+
+	decimalPlaces - target decimal places (default is 16)
+	roundingMode - rounding mode of result (default is RoundHalfUp)
+
+Result is in range [0, pi]. Returns error for argument out of range [-1, 1].
+*/
+func (f *BigFloat) Acos(x *BigFloat, options ...RoundOption) (*BigFloat, error) {
+	ro, err := mathOptions(options, x)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkAsinArgument(x); err != nil {
+		return nil, err
+	}
+
+	r, _ := roundResult(ro, func(decimals int) (*BigFloat, error) {
+		halfPi := divWP(pi(decimals), SetInt64(2), decimals)
+
+		return New().Sub(halfPi, asin(x, decimals)), nil
+	})
+	f.analysis = r.analysis
+
+	return f, nil
+}
@@ -0,0 +1,290 @@
+package bigfloat
+
+import (
+	"fmt"
+	"testing"
+)
+
+var trigCases = []struct {
+	param       string
+	decimals    int
+	expectedSin string
+	expectedCos string
+}{
+	{"0", 5, "0.00000", "1.00000"},
+	{"1", 30, "0.841470984807896506652502321630", "0.540302305868139717400936607443"},
+	{"-1", 20, "-0.84147098480789650665", "0.54030230586813971740"},
+	{"3.14159265358979323846", 25, "0.0000000000000000000026434", "-1.0000000000000000000000000"},
+	{"100", 20, "-0.50636564110975879366", "0.86231887228768393410"},
+	{"1e50", 20, "-0.78967249342931008271", "-0.61352860823366356226"},
+	{"0.5", 40, "0.4794255386042030002732879352155713880818", "0.8775825618903727161162815826038296519916"},
+	{"1.5707963267948966", 20, "1.00000000000000000000", "0.00000000000000001923"},
+	{"-7.25", 15, "-0.823080879011505", "0.567924173288695"},
+}
+
+func TestSin(t *testing.T) {
+	fmt.Printf("\nTestSin...\n")
+	for _, c := range trigCases {
+		fmt.Printf("sin(%v, %v) = ", c.param, c.decimals)
+		n1, err := createBigFloat(t, c.param)
+		if err != nil {
+			continue
+		}
+
+		n2 := New()
+		_, err = n2.Sin(n1, WithDecimalPlaces(c.decimals))
+
+		expectedStr := c.expectedSin
+		result := n2.String()
+
+		fmt.Printf("%v\n", result)
+		printResult(t, result, expectedStr, err)
+	}
+}
+
+func TestCos(t *testing.T) {
+	fmt.Printf("\nTestCos...\n")
+	for _, c := range trigCases {
+		fmt.Printf("cos(%v, %v) = ", c.param, c.decimals)
+		n1, err := createBigFloat(t, c.param)
+		if err != nil {
+			continue
+		}
+
+		n2 := New()
+		_, err = n2.Cos(n1, WithDecimalPlaces(c.decimals))
+
+		expectedStr := c.expectedCos
+		result := n2.String()
+
+		fmt.Printf("%v\n", result)
+		printResult(t, result, expectedStr, err)
+	}
+}
+
+func TestTan(t *testing.T) {
+	var cases = []struct {
+		param    string
+		decimals int
+		expected string
+	}{
+		{"0", 5, "0.00000"},
+		{"1", 30, "1.557407724654902230506974807458"},
+		{"-1", 20, "-1.55740772465490223051"},
+		{"0.785398163397448309615660845819875721", 20, "1.00000000000000000000"},
+		{"1.5707963267948966", 10, "51998506188720270.6601947417"},
+		{"1e50", 20, "1.28709970950297036450"},
+		{"2", 16, "-2.1850398632615190"},
+		{"1.57079632679", 0, "204222536562"},
+		{"-4.71238898038", 0, "-213226086004"},
+		{"1.570796326794896619231", 2, "3108566951794764943058.71"},
+		{"1.570796326794896619231", 16, "3108566951794764943058.7123227017045847"},
+	}
+	fmt.Printf("\nTestTan...\n")
+	for _, c := range cases {
+		fmt.Printf("tan(%v, %v) = ", c.param, c.decimals)
+		n1, err := createBigFloat(t, c.param)
+		if err != nil {
+			continue
+		}
+
+		n2 := New()
+		_, err = n2.Tan(n1, WithDecimalPlaces(c.decimals))
+
+		expectedStr := c.expected
+		result := n2.String()
+
+		fmt.Printf("%v\n", result)
+		printResult(t, result, expectedStr, err)
+	}
+}
+
+func TestErrorsTrig(t *testing.T) {
+	var cases = []struct {
+		param    string
+		decimals int
+	}{
+		{"1", -1},
+	}
+
+	fmt.Printf("\nTestErrorsTrig...\n")
+	for _, c := range cases {
+		fmt.Printf("tan(%v, %v) = ", c.param, c.decimals)
+		n1, err := createBigFloat(t, c.param)
+		if err != nil {
+			continue
+		}
+		func() {
+			defer func() {
+				if err := recover(); err != nil {
+					fmt.Printf("\nOK: panic occurred: %v\n", err)
+				}
+			}()
+
+			n2 := New()
+			_, err := n2.Tan(n1, WithDecimalPlaces(c.decimals))
+			if err != nil {
+				panic(err)
+			}
+
+			errorStr := fmt.Sprintf("%v should raise panic", c)
+			fmt.Printf("\n" + errorStr + "\n")
+			t.Errorf(errorStr)
+		}()
+	}
+}
+
+func TestAtan(t *testing.T) {
+	var cases = []struct {
+		param    string
+		decimals int
+		expected string
+	}{
+		{"0", 5, "0.00000"},
+		{"1", 30, "0.785398163397448309615660845820"},
+		{"-1", 20, "-0.78539816339744830962"},
+		{"0.5", 25, "0.4636476090008061162142562"},
+		{"10", 20, "1.47112767430373459185"},
+		{"-1e20", 20, "-1.57079632679489661922"},
+		{"0.0001", 20, "0.00009999999966666667"},
+		{"123.456", 16, "1.5626964520979926"},
+	}
+	fmt.Printf("\nTestAtan...\n")
+	for _, c := range cases {
+		fmt.Printf("atan(%v, %v) = ", c.param, c.decimals)
+		n1, err := createBigFloat(t, c.param)
+		if err != nil {
+			continue
+		}
+
+		n2 := New()
+		_, err = n2.Atan(n1, WithDecimalPlaces(c.decimals))
+
+		expectedStr := c.expected
+		result := n2.String()
+
+		fmt.Printf("%v\n", result)
+		printResult(t, result, expectedStr, err)
+	}
+}
+
+func TestAtan2(t *testing.T) {
+	var cases = []struct {
+		param1   string
+		param2   string
+		decimals int
+		expected string
+	}{
+		{"0", "0", 5, "0.00000"},
+		{"0", "1", 5, "0.00000"},
+		{"0", "-1", 20, "3.14159265358979323846"},
+		{"1", "0", 20, "1.57079632679489661923"},
+		{"-1", "0", 20, "-1.57079632679489661923"},
+		{"1", "1", 20, "0.78539816339744830962"},
+		{"1", "-1", 20, "2.35619449019234492885"},
+		{"-1", "-1", 20, "-2.35619449019234492885"},
+		{"-1", "1", 20, "-0.78539816339744830962"},
+		{"3", "-4", 25, "2.4980915447965088516598342"},
+		{"-0.5", "-0.0001", 16, "-1.5709963267922300"},
+		{"-0", "1", 5, "-0.00000"},
+		{"-0", "0", 5, "-0.00000"},
+		{"0", "-0", 5, "3.14159"},
+		{"-0", "-0", 5, "-3.14159"},
+		{"-0", "-1", 20, "-3.14159265358979323846"},
+		{"1", "-0", 5, "1.57080"},
+		{"-1", "-0", 5, "-1.57080"},
+	}
+	fmt.Printf("\nTestAtan2...\n")
+	for _, c := range cases {
+		fmt.Printf("atan2(%v, %v, %v) = ", c.param1, c.param2, c.decimals)
+		n1, n2, err := create2BigFloats(t, c.param1, c.param2, WithParseNegZero(true))
+		if err != nil {
+			continue
+		}
+
+		n3 := New()
+		_, err = n3.Atan2(n1, n2, WithDecimalPlaces(c.decimals))
+
+		expectedStr := c.expected
+		result := n3.StringWith(SignedZero(true))
+
+		fmt.Printf("%v\n", result)
+		printResult(t, result, expectedStr, err)
+	}
+}
+
+func TestAsinAcos(t *testing.T) {
+	var cases = []struct {
+		param        string
+		decimals     int
+		expectedAsin string
+		expectedAcos string
+	}{
+		{"0", 5, "0.00000", "1.57080"},
+		{"1", 20, "1.57079632679489661923", "0.00000000000000000000"},
+		{"-1", 20, "-1.57079632679489661923", "3.14159265358979323846"},
+		{"0.5", 30, "0.523598775598298873077107230547", "1.047197551196597746154214461093"},
+		{"-0.3", 20, "-0.30469265401539750797", "1.87548898081029412720"},
+		{"0.9999", 20, "1.55665407331738374164", "0.01414225347751287760"},
+		{"0.001", 16, "0.0010000001666667", "1.5697963266282299"},
+	}
+	fmt.Printf("\nTestAsinAcos...\n")
+	for _, c := range cases {
+		fmt.Printf("asin(%v, %v), acos(%v, %v) = ", c.param, c.decimals, c.param, c.decimals)
+		n1, err := createBigFloat(t, c.param)
+		if err != nil {
+			continue
+		}
+
+		n2 := New()
+		_, err = n2.Asin(n1, WithDecimalPlaces(c.decimals))
+		if err != nil {
+			t.Errorf("Asin error %v", err)
+			continue
+		}
+		n3 := New()
+		_, err = n3.Acos(n1, WithDecimalPlaces(c.decimals))
+
+		expectedStr := fmt.Sprintf("%v, %v", c.expectedAsin, c.expectedAcos)
+		result := fmt.Sprintf("%v, %v", n2.String(), n3.String())
+
+		fmt.Printf("%v\n", result)
+		printResult(t, result, expectedStr, err)
+	}
+}
+
+func TestErrorsAsinAcos(t *testing.T) {
+	var cases = []struct {
+		param string
+	}{
+		{"1.0000001"},
+		{"-2"},
+	}
+
+	fmt.Printf("\nTestErrorsAsinAcos...\n")
+	for _, c := range cases {
+		n1, err := createBigFloat(t, c.param)
+		if err != nil {
+			continue
+		}
+		for _, fn := range []func(*BigFloat, ...RoundOption) (*BigFloat, error){New().Asin, New().Acos} {
+			fmt.Printf("asin/acos(%v) = ", c.param)
+			func() {
+				defer func() {
+					if err := recover(); err != nil {
+						fmt.Printf("\nOK: panic occurred: %v\n", err)
+					}
+				}()
+
+				_, err := fn(n1)
+				if err != nil {
+					panic(err)
+				}
+
+				errorStr := fmt.Sprintf("%v should raise panic", c)
+				fmt.Printf("\n" + errorStr + "\n")
+				t.Errorf(errorStr)
+			}()
+		}
+	}
+}